@@ -0,0 +1,188 @@
+// Package analytics derives status timelines, time in status, lead time and cycle time of Jira issues
+// from their changelog.
+//
+// The issue must be fetched with its full changelog, e.g. by IssuesService.ListChangelogsPager,
+// because `expand=changelog` is truncated to the latest 100 histories.
+package analytics
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/zdz1715/go-jira"
+)
+
+// Status category keys of Jira
+const (
+	CategoryToDo       = "new"
+	CategoryInProgress = "indeterminate"
+	CategoryDone       = "done"
+)
+
+// StatusInterval represents a period the issue stayed in a status.
+type StatusInterval struct {
+	StatusID string
+	Status   string
+	// Category is the key of the status category, empty if unknown.
+	Category string
+	Start    time.Time
+	// End is Options.Now for the current status.
+	End time.Time
+}
+
+// Duration returns the elapsed time of the interval.
+func (i StatusInterval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
+
+type Options struct {
+	// Now ends the interval of the current status. Default: time.Now()
+	Now time.Time
+	// Categories maps a status id or name to the key of its status category.
+	// The changelog has no categories, only the category of the current status is known from the issue.
+	Categories map[string]string
+}
+
+func (o *Options) category(id, name string) string {
+	if o == nil {
+		return ""
+	}
+	if category, ok := o.Categories[id]; ok {
+		return category
+	}
+	return o.Categories[name]
+}
+
+// Timeline returns the status intervals of the issue from its creation, oldest first.
+// Fields.Created and Fields.Status of the issue are required.
+func Timeline(issue *jira.Issue, opts *Options) []StatusInterval {
+	if issue == nil || issue.Fields == nil || issue.Fields.Created == nil {
+		return nil
+	}
+	now := time.Now()
+	if opts != nil && !opts.Now.IsZero() {
+		now = opts.Now
+	}
+
+	type change struct {
+		at        time.Time
+		historyID int64
+		item      jira.ChangelogItems
+	}
+	var changes []change
+	if issue.Changelog != nil {
+		for _, history := range issue.Changelog.Histories {
+			id, _ := strconv.ParseInt(history.Id, 10, 64)
+			for _, item := range history.Items {
+				if item.Field == "status" {
+					changes = append(changes, change{at: history.Created.Time, historyID: id, item: item})
+				}
+			}
+		}
+	}
+	// expand=changelog returns the newest first, the changelog endpoints the oldest first.
+	// The ids of the histories are increasing, they order the changes of the same time,
+	// the items of a history keep their order.
+	sort.SliceStable(changes, func(i, j int) bool {
+		if !changes[i].at.Equal(changes[j].at) {
+			return changes[i].at.Before(changes[j].at)
+		}
+		return changes[i].historyID < changes[j].historyID
+	})
+
+	var current StatusInterval
+	if len(changes) > 0 {
+		first := changes[0].item
+		current = StatusInterval{StatusID: first.From, Status: first.FromString}
+	} else if issue.Fields.Status != nil {
+		current = StatusInterval{StatusID: issue.Fields.Status.ID, Status: issue.Fields.Status.Name}
+	}
+	current.Start = issue.Fields.Created.Time
+	current.Category = opts.category(current.StatusID, current.Status)
+
+	intervals := make([]StatusInterval, 0, len(changes)+1)
+	for _, c := range changes {
+		current.End = c.at
+		intervals = append(intervals, current)
+		current = StatusInterval{
+			StatusID: c.item.To,
+			Status:   c.item.ToString,
+			Category: opts.category(c.item.To, c.item.ToString),
+			Start:    c.at,
+		}
+	}
+	current.End = now
+	if status := issue.Fields.Status; status != nil && status.ID == current.StatusID && status.StatusCategory.Key != "" {
+		current.Category = status.StatusCategory.Key
+	}
+	return append(intervals, current)
+}
+
+// TimeInStatus returns the total time spent in each status by name.
+// The time is measured by cal, or elapsed if cal is nil.
+func TimeInStatus(intervals []StatusInterval, cal Calendar) map[string]time.Duration {
+	result := make(map[string]time.Duration)
+	for _, interval := range intervals {
+		result[interval.Status] += duration(cal, interval.Start, interval.End)
+	}
+	return result
+}
+
+// TimeInCategory returns the total time spent in each status category by key, see TimeInStatus.
+func TimeInCategory(intervals []StatusInterval, cal Calendar) map[string]time.Duration {
+	result := make(map[string]time.Duration)
+	for _, interval := range intervals {
+		result[interval.Category] += duration(cal, interval.Start, interval.End)
+	}
+	return result
+}
+
+// CycleTime returns the time from the first time the issue entered a status of the start categories
+// to the time it entered its current status, if the current status is in the end categories.
+// It reports false if the issue never started or is not finished, e.g. reopened.
+// The time is measured by cal, or elapsed if cal is nil.
+func CycleTime(intervals []StatusInterval, start, end []string, cal Calendar) (time.Duration, bool) {
+	for _, interval := range intervals {
+		if contains(start, interval.Category) {
+			return span(intervals, interval.Start, end, cal)
+		}
+	}
+	return 0, false
+}
+
+// LeadTime returns the time from the creation of the issue to the time it entered its current status,
+// if the current status is in the end categories, see CycleTime.
+func LeadTime(intervals []StatusInterval, end []string, cal Calendar) (time.Duration, bool) {
+	if len(intervals) == 0 {
+		return 0, false
+	}
+	return span(intervals, intervals[0].Start, end, cal)
+}
+
+func span(intervals []StatusInterval, from time.Time, end []string, cal Calendar) (time.Duration, bool) {
+	last := intervals[len(intervals)-1]
+	if !contains(end, last.Category) {
+		return 0, false
+	}
+	return duration(cal, from, last.Start), true
+}
+
+func duration(cal Calendar, start, end time.Time) time.Duration {
+	if !end.After(start) {
+		return 0
+	}
+	if cal == nil {
+		return end.Sub(start)
+	}
+	return cal.WorkingTime(start, end)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package analytics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zdz1715/go-jira"
+)
+
+func at(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func testIssue() *jira.Issue {
+	status := func(created, from, fromString, to, toString string) *jira.ChangelogHistory {
+		return &jira.ChangelogHistory{
+			Created: jira.Time{Time: at(created)},
+			Items:   []jira.ChangelogItems{{Field: "status", From: from, FromString: fromString, To: to, ToString: toString}},
+		}
+	}
+	issue := &jira.Issue{
+		Fields: &jira.IssueFields{
+			Created: &jira.Time{Time: at("2024-01-01T09:00:00Z")},
+			Status:  &jira.Status{ID: "3", Name: "Done"},
+		},
+		Changelog: &jira.Changelog{
+			// newest first, as returned by expand=changelog
+			Histories: []jira.ChangelogHistory{
+				*status("2024-01-03T09:00:00Z", "2", "In Progress", "3", "Done"),
+				*status("2024-01-02T09:00:00Z", "1", "To Do", "2", "In Progress"),
+			},
+		},
+	}
+	issue.Fields.Status.StatusCategory.Key = CategoryDone
+	return issue
+}
+
+func TestTimeline(t *testing.T) {
+	intervals := Timeline(testIssue(), &Options{
+		Now:        at("2024-01-04T09:00:00Z"),
+		Categories: map[string]string{"1": CategoryToDo, "In Progress": CategoryInProgress},
+	})
+	want := []StatusInterval{
+		{StatusID: "1", Status: "To Do", Category: CategoryToDo, Start: at("2024-01-01T09:00:00Z"), End: at("2024-01-02T09:00:00Z")},
+		{StatusID: "2", Status: "In Progress", Category: CategoryInProgress, Start: at("2024-01-02T09:00:00Z"), End: at("2024-01-03T09:00:00Z")},
+		{StatusID: "3", Status: "Done", Category: CategoryDone, Start: at("2024-01-03T09:00:00Z"), End: at("2024-01-04T09:00:00Z")},
+	}
+	if len(intervals) != len(want) {
+		t.Fatalf("Timeline() = %+v", intervals)
+	}
+	for i := range want {
+		if intervals[i] != want[i] {
+			t.Errorf("Timeline()[%d] = %+v, want %+v", i, intervals[i], want[i])
+		}
+	}
+
+	byStatus := TimeInStatus(intervals, nil)
+	if byStatus["In Progress"] != 24*time.Hour {
+		t.Errorf("TimeInStatus() = %v", byStatus)
+	}
+	byCategory := TimeInCategory(intervals, nil)
+	if byCategory[CategoryDone] != 24*time.Hour {
+		t.Errorf("TimeInCategory() = %v", byCategory)
+	}
+
+	if d, ok := LeadTime(intervals, []string{CategoryDone}, nil); !ok || d != 48*time.Hour {
+		t.Errorf("LeadTime() = %v, %v", d, ok)
+	}
+	if d, ok := CycleTime(intervals, []string{CategoryInProgress}, []string{CategoryDone}, nil); !ok || d != 24*time.Hour {
+		t.Errorf("CycleTime() = %v, %v", d, ok)
+	}
+	if _, ok := CycleTime(intervals[:2], []string{CategoryInProgress}, []string{CategoryDone}, nil); ok {
+		t.Errorf("CycleTime() of an unfinished issue reports ok")
+	}
+}
+
+func TestTimeline_NoChangelog(t *testing.T) {
+	issue := testIssue()
+	issue.Changelog = nil
+	intervals := Timeline(issue, &Options{Now: at("2024-01-02T09:00:00Z")})
+	if len(intervals) != 1 || intervals[0].Status != "Done" || intervals[0].Duration() != 24*time.Hour {
+		t.Errorf("Timeline() = %+v", intervals)
+	}
+}
+
+func TestWorkingHours_WorkingTime(t *testing.T) {
+	cal := &WorkingHours{
+		Location: time.UTC,
+		Start:    9 * time.Hour,
+		End:      17 * time.Hour,
+		Holidays: []time.Time{at("2024-01-03T00:00:00Z")},
+	}
+	tests := []struct {
+		name       string
+		start, end string
+		want       time.Duration
+	}{
+		{"within a day", "2024-01-01T10:00:00Z", "2024-01-01T12:00:00Z", 2 * time.Hour},
+		{"outside hours", "2024-01-01T18:00:00Z", "2024-01-02T08:00:00Z", 0},
+		{"overnight", "2024-01-01T16:00:00Z", "2024-01-02T10:00:00Z", 2 * time.Hour},
+		{"holiday", "2024-01-02T09:00:00Z", "2024-01-04T09:00:00Z", 8 * time.Hour},
+		{"weekend", "2024-01-05T16:00:00Z", "2024-01-08T10:00:00Z", 2 * time.Hour},
+		{"reversed", "2024-01-02T10:00:00Z", "2024-01-01T10:00:00Z", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cal.WorkingTime(at(tt.start), at(tt.end)); got != tt.want {
+				t.Errorf("WorkingTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkingHours_WorkingTime_Location(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	cal := &WorkingHours{
+		Location: loc,
+		Start:    9 * time.Hour,
+		End:      17 * time.Hour,
+		Holidays: []time.Time{at("2024-01-03T00:00:00Z")},
+	}
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       time.Duration
+	}{
+		{"day before the holiday", time.Date(2024, 1, 2, 9, 0, 0, 0, loc), time.Date(2024, 1, 2, 17, 0, 0, 0, loc), 8 * time.Hour},
+		{"holiday", time.Date(2024, 1, 3, 9, 0, 0, 0, loc), time.Date(2024, 1, 3, 17, 0, 0, 0, loc), 0},
+		{"working hours of the location", at("2024-01-04T13:00:00Z"), at("2024-01-04T23:00:00Z"), 8 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cal.WorkingTime(tt.start, tt.end); got != tt.want {
+				t.Errorf("WorkingTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkingHours_WorkingTime_DST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// the clocks move forward at 2:00 on 2024-03-10, the working hours still start at 9:00 on the wall clock
+	cal := &WorkingHours{Location: loc, Start: 9 * time.Hour, End: 17*time.Hour + 30*time.Minute, Weekdays: []time.Weekday{time.Sunday}}
+	start, end := time.Date(2024, 3, 10, 0, 0, 0, 0, loc), time.Date(2024, 3, 10, 17, 0, 0, 0, loc)
+	if got, want := cal.WorkingTime(start, end), 8*time.Hour; got != want {
+		t.Errorf("WorkingTime() = %v, want %v", got, want)
+	}
+}
+
+func TestTimeline_SameTime(t *testing.T) {
+	issue := testIssue()
+	// newest first, both changes at the same time, ordered by the ids of the histories
+	issue.Changelog.Histories = []jira.ChangelogHistory{
+		{Id: "11", Created: jira.Time{Time: at("2024-01-02T09:00:00Z")}, Items: []jira.ChangelogItems{{Field: "status", From: "2", FromString: "In Progress", To: "3", ToString: "Done"}}},
+		{Id: "10", Created: jira.Time{Time: at("2024-01-02T09:00:00Z")}, Items: []jira.ChangelogItems{{Field: "status", From: "1", FromString: "To Do", To: "2", ToString: "In Progress"}}},
+	}
+	intervals := Timeline(issue, &Options{Now: at("2024-01-03T09:00:00Z")})
+	var statuses []string
+	for _, interval := range intervals {
+		statuses = append(statuses, interval.Status)
+	}
+	if got := strings.Join(statuses, ","); got != "To Do,In Progress,Done" {
+		t.Errorf("Timeline() statuses = %s", got)
+	}
+}
@@ -0,0 +1,84 @@
+package analytics
+
+import "time"
+
+// Calendar measures the working time between two points in time.
+type Calendar interface {
+	WorkingTime(start, end time.Time) time.Duration
+}
+
+// WorkingHours is a Calendar of the same working hours on every working day.
+type WorkingHours struct {
+	// Location of the working hours. Default: time.Local
+	Location *time.Location
+	// Start and End of the working hours as the offset from midnight, e.g. 9 * time.Hour and 17 * time.Hour.
+	Start, End time.Duration
+	// Weekdays are the working days. Default: Monday to Friday
+	Weekdays []time.Weekday
+	// Holidays are the dates which are not working days, the time of day and the location are ignored.
+	Holidays []time.Time
+}
+
+func (w *WorkingHours) isWorkingDay(day time.Time) bool {
+	weekdays := w.Weekdays
+	if len(weekdays) == 0 {
+		weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	}
+	working := false
+	for _, weekday := range weekdays {
+		if day.Weekday() == weekday {
+			working = true
+			break
+		}
+	}
+	if !working {
+		return false
+	}
+	for _, holiday := range w.Holidays {
+		// the date as written, converting a UTC midnight to a negative offset would move it to the day before
+		y, m, d := holiday.Date()
+		if dy, dm, dd := day.Date(); y == dy && m == dm && d == dd {
+			return false
+		}
+	}
+	return true
+}
+
+// WorkingTime returns the time between start and end which is within the working hours.
+func (w *WorkingHours) WorkingTime(start, end time.Time) time.Duration {
+	if !end.After(start) || w.End <= w.Start {
+		return 0
+	}
+	loc := w.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	start, end = start.In(loc), end.In(loc)
+
+	var total time.Duration
+	y, m, d := start.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, loc); day.Before(end); day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, loc) {
+		if !w.isWorkingDay(day) {
+			continue
+		}
+		// add the offsets to the wall clock of the day, so a DST change does not shift the working hours
+		from := wallClock(day, w.Start)
+		to := wallClock(day, w.End)
+		if from.Before(start) {
+			from = start
+		}
+		if to.After(end) {
+			to = end
+		}
+		if to.After(from) {
+			total += to.Sub(from)
+		}
+	}
+	return total
+}
+
+// wallClock returns the time of the offset from midnight on the wall clock of day.
+// The offset is passed as seconds, its nanoseconds overflow the int of 32-bit platforms.
+func wallClock(day time.Time, offset time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, int(offset/time.Second), int(offset%time.Second), day.Location())
+}
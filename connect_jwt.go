@@ -0,0 +1,167 @@
+package jira
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zdz1715/ghttp"
+)
+
+const (
+	// DefaultConnectJWTExpiry is the lifetime of the tokens, a token is generated for every request.
+	DefaultConnectJWTExpiry = 3 * time.Minute
+	// DefaultConnectJWTClockSkew is subtracted from the issue time, in case the clock of Jira is behind.
+	DefaultConnectJWTClockSkew = 30 * time.Second
+)
+
+// ConnectInstallation represents the payload of the `installed` lifecycle callback of an Atlassian Connect app.
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/connect-app-descriptor/#lifecycle
+type ConnectInstallation struct {
+	Key            string `json:"key"`
+	ClientKey      string `json:"clientKey"`
+	SharedSecret   string `json:"sharedSecret"`
+	BaseURL        string `json:"baseUrl"`
+	DisplayURL     string `json:"displayUrl,omitempty"`
+	CloudID        string `json:"cloudId,omitempty"`
+	ProductType    string `json:"productType,omitempty"`
+	Description    string `json:"description,omitempty"`
+	EventType      string `json:"eventType,omitempty"`
+	ServerVersion  string `json:"serverVersion,omitempty"`
+	PluginsVersion string `json:"pluginsVersion,omitempty"`
+}
+
+// Credential returns the ConnectJWT calling the installed instance as the app.
+func (i *ConnectInstallation) Credential() *ConnectJWT {
+	return &ConnectJWT{
+		Endpoint:     i.BaseURL,
+		Issuer:       i.Key,
+		SharedSecret: i.SharedSecret,
+	}
+}
+
+// ConnectJWT signs every request by a JWT of an Atlassian Connect app, with the query string hash (qsh)
+// of the final request.
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/understanding-jwt-for-connect-apps/
+type ConnectJWT struct {
+	// Endpoint is the baseUrl of the installation.
+	Endpoint string `json:"endpoint" xml:"endpoint"`
+	// Issuer is the key of the app.
+	Issuer string `json:"issuer" xml:"issuer"`
+	// SharedSecret of the installation.
+	SharedSecret string `json:"sharedSecret" xml:"sharedSecret"`
+	// Expiry of the tokens. Default: DefaultConnectJWTExpiry
+	Expiry time.Duration `json:"expiry,omitempty" xml:"expiry,omitempty"`
+	// ClockSkew is subtracted from the issue time. Default: DefaultConnectJWTClockSkew
+	ClockSkew time.Duration `json:"clockSkew,omitempty" xml:"clockSkew,omitempty"`
+}
+
+func (j *ConnectJWT) GetEndpoint() string {
+	return j.Endpoint
+}
+
+func (j *ConnectJWT) GenerateCallOptions() (*ghttp.CallOptions, error) {
+	return &ghttp.CallOptions{
+		// the qsh covers the query, which is added by ghttp, so the token is generated for the final request
+		BeforeHook: func(req *http.Request) error {
+			token, err := j.Token(req.Method, req.URL)
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Authorization", "JWT "+token)
+			return nil
+		},
+	}, nil
+}
+
+func (j *ConnectJWT) Valid() error {
+	if j.Endpoint == "" || j.Issuer == "" || j.SharedSecret == "" {
+		return ErrCredential
+	}
+	return nil
+}
+
+// Token returns a JWT for the request, signed by HS256.
+func (j *ConnectJWT) Token(method string, u *url.URL) (string, error) {
+	expiry, skew := j.Expiry, j.ClockSkew
+	if expiry <= 0 {
+		expiry = DefaultConnectJWTExpiry
+	}
+	if skew <= 0 {
+		skew = DefaultConnectJWTClockSkew
+	}
+	now := time.Now()
+	claims := map[string]interface{}{
+		"iss": j.Issuer,
+		"iat": now.Add(-skew).Unix(),
+		"exp": now.Add(expiry).Unix(),
+		"qsh": QueryStringHash(method, u, j.Endpoint),
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(j.SharedSecret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// QueryStringHash returns the qsh claim of the request, the hex encoded SHA-256 of its canonical request.
+// baseURL is the baseUrl of the installation, its path is removed from the path of the request.
+func QueryStringHash(method string, u *url.URL, baseURL string) string {
+	sum := sha256.Sum256([]byte(canonicalRequest(method, u, baseURL)))
+	return hex.EncodeToString(sum[:])
+}
+
+// canonicalRequest returns `METHOD&path&query`, see
+// https://developer.atlassian.com/cloud/jira/platform/understanding-jwt-for-connect-apps/#qsh
+func canonicalRequest(method string, u *url.URL, baseURL string) string {
+	path := u.EscapedPath()
+	if base, err := url.Parse(baseURL); err == nil {
+		path = strings.TrimPrefix(path, strings.TrimRight(base.EscapedPath(), "/"))
+	}
+	if path != "/" {
+		path = strings.TrimRight(path, "/")
+	}
+	if path == "" {
+		path = "/"
+	}
+	path = strings.ReplaceAll(path, "&", "%26")
+
+	query := u.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		// the token itself may be sent as the jwt parameter
+		if key != "jwt" {
+			keys = append(keys, key)
+		}
+	}
+	// sorted by the encoded keys, sorting the `key=value` pairs would put `a-` before `a`
+	sort.Slice(keys, func(i, j int) bool {
+		return percentEncode(keys[i]) < percentEncode(keys[j])
+	})
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := make([]string, 0, len(query[key]))
+		for _, value := range query[key] {
+			values = append(values, percentEncode(value))
+		}
+		sort.Strings(values)
+		params = append(params, percentEncode(key)+"="+strings.Join(values, ","))
+	}
+
+	return strings.ToUpper(method) + "&" + path + "&" + strings.Join(params, "&")
+}
@@ -0,0 +1,111 @@
+package jira
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCanonicalRequest(t *testing.T) {
+	tests := []struct {
+		method, url, baseURL string
+		want                 string
+	}{
+		{
+			method: "get", url: "https://example.atlassian.net/rest/api/2/search?maxResults=10&jql=project%20%3D%20TEST&expand=names&expand=changelog&jwt=token",
+			baseURL: "https://example.atlassian.net",
+			want:    "GET&/rest/api/2/search&expand=changelog,names&jql=project%20%3D%20TEST&maxResults=10",
+		},
+		{
+			method: "POST", url: "https://example.com/jira/rest/api/2/issue/?a-=1&a=2&b=%2A~",
+			baseURL: "https://example.com/jira/",
+			want:    "POST&/rest/api/2/issue&a=2&a-=1&b=%2A~",
+		},
+		{
+			method: "GET", url: "https://example.com/jira", baseURL: "https://example.com/jira",
+			want: "GET&/&",
+		},
+		{
+			method: "GET", url: "https://example.com/a&b", baseURL: "https://example.com",
+			want: "GET&/a%26b&",
+		},
+	}
+	for _, tt := range tests {
+		u, err := url.Parse(tt.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := canonicalRequest(tt.method, u, tt.baseURL); got != tt.want {
+			t.Errorf("canonicalRequest(%s %s) = %s, want %s", tt.method, tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestConnectJWT(t *testing.T) {
+	var authorization string
+	var requestURL *url.URL
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		requestURL = r.URL
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"values":[]}`))
+	}))
+	defer server.Close()
+
+	var installation ConnectInstallation
+	if err := json.Unmarshal([]byte(`{"key":"my-app","clientKey":"client","sharedSecret":"secret","baseUrl":"`+server.URL+`","eventType":"installed"}`), &installation); err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewClient(installation.Credential(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Project.ListProjects(context.Background(), &ListProjectOptions{
+		SearchOptions: &SearchOptions{MaxResults: 10, Expand: "lead"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	token := strings.TrimPrefix(authorization, "JWT ")
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("got Authorization %q", authorization)
+	}
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if base64.RawURLEncoding.EncodeToString(mac.Sum(nil)) != parts[2] {
+		t.Error("invalid signature")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var claims struct {
+		Iss string `json:"iss"`
+		Iat int64  `json:"iat"`
+		Exp int64  `json:"exp"`
+		Qsh string `json:"qsh"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().Unix()
+	if claims.Iss != "my-app" || claims.Iat > now || claims.Exp <= now || claims.Exp-claims.Iat > int64((DefaultConnectJWTExpiry+DefaultConnectJWTClockSkew)/time.Second) {
+		t.Errorf("got claims %+v", claims)
+	}
+	// the SHA-256 of `GET&/rest/api/2/project/search&expand=lead&maxResults=10`
+	if want := "561297a496ca17bc989dc2a9486f4c5773983f809f0e98da944d6e634c522ba2"; claims.Qsh != want {
+		t.Errorf("got qsh %s, want %s of %s", claims.Qsh, want, requestURL)
+	}
+	if requestURL.Query().Get("expand") != "lead" {
+		t.Errorf("got URL %s", requestURL)
+	}
+}
@@ -2,6 +2,8 @@ package jira
 
 import (
 	"errors"
+	"net/http"
+
 	"github.com/zdz1715/ghttp"
 )
 
@@ -41,3 +43,30 @@ func (ba *BasicAuth) Valid() error {
 	}
 	return nil
 }
+
+// BearerToken sends a Personal Access Token of Jira Server and Data Center as `Authorization: Bearer`.
+// Jira docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+type BearerToken struct {
+	Endpoint string `json:"endpoint" xml:"endpoint"`
+	Token    string `json:"token" xml:"token"`
+}
+
+func (bt *BearerToken) GetEndpoint() string {
+	return bt.Endpoint
+}
+
+func (bt *BearerToken) GenerateCallOptions() (*ghttp.CallOptions, error) {
+	return &ghttp.CallOptions{
+		BeforeHook: func(req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+bt.Token)
+			return nil
+		},
+	}, nil
+}
+
+func (bt *BearerToken) Valid() error {
+	if bt.Endpoint == "" || bt.Token == "" {
+		return ErrCredential
+	}
+	return nil
+}
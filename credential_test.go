@@ -0,0 +1,63 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBearerToken(t *testing.T) {
+	var authorization []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = append(authorization, r.Header.Get("Authorization"))
+		if r.URL.Path == "/rest/api/2/myself" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"name":"fred"}`))
+			return
+		}
+		_, _ = w.Write([]byte("content"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BearerToken{Endpoint: server.URL, Token: "pat"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.User.GetCurrentUser(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if _, err := client.Issue.DownloadAttachment(context.Background(), &Attachment{Content: server.URL + "/secure/attachment/1/a.txt"}, &b); err != nil {
+		t.Fatal(err)
+	}
+	for _, got := range authorization {
+		if got != "Bearer pat" {
+			t.Errorf("got Authorization %q, want %q", got, "Bearer pat")
+		}
+	}
+	if len(authorization) != 2 {
+		t.Errorf("got %d requests, want 2", len(authorization))
+	}
+}
+
+func TestBearerToken_Valid(t *testing.T) {
+	if err := (&BearerToken{Endpoint: "https://jira.example.com"}).Valid(); err != ErrCredential {
+		t.Errorf("got %v, want ErrCredential", err)
+	}
+}
+
+func TestBearerToken_GenerateCallOptions(t *testing.T) {
+	callOpts, err := (&BearerToken{Endpoint: "https://jira.example.com", Token: "pat"}).GenerateCallOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "https://jira.example.com/rest/api/2/myself", nil)
+	if err := callOpts.BeforeHook(req); err != nil {
+		t.Fatal(err)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer pat" {
+		t.Errorf("got Authorization %q, want %q", got, "Bearer pat")
+	}
+}
@@ -0,0 +1,163 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// EntityPropertiesService stores custom data as JSON properties of issues, projects, users, comments and worklogs.
+type EntityPropertiesService service
+
+// PropertyEntity identifies the entity of the properties, see IssueEntity, ProjectEntity, UserEntity, CommentEntity and WorklogEntity.
+type PropertyEntity struct {
+	path string
+	// user of UserEntity, identified when the request is sent, since it depends on the deployment type
+	user *User
+}
+
+// IssueEntity returns the PropertyEntity of an issue.
+func IssueEntity(issueIdOrKey string) PropertyEntity {
+	return PropertyEntity{path: fmt.Sprintf("/rest/api/2/issue/%s/properties", issueIdOrKey)}
+}
+
+// ProjectEntity returns the PropertyEntity of a project.
+func ProjectEntity(projectIdOrKey string) PropertyEntity {
+	return PropertyEntity{path: fmt.Sprintf("/rest/api/2/project/%s/properties", projectIdOrKey)}
+}
+
+// CommentEntity returns the PropertyEntity of a comment.
+func CommentEntity(commentId string) PropertyEntity {
+	return PropertyEntity{path: fmt.Sprintf("/rest/api/2/comment/%s/properties", commentId)}
+}
+
+// WorklogEntity returns the PropertyEntity of a worklog of an issue.
+func WorklogEntity(issueIdOrKey, worklogId string) PropertyEntity {
+	return PropertyEntity{path: fmt.Sprintf("/rest/api/2/issue/%s/worklog/%s/properties", issueIdOrKey, worklogId)}
+}
+
+// UserEntity returns the PropertyEntity of a user, identified by AccountID on Cloud, or by Name on Server.
+func UserEntity(user *User) PropertyEntity {
+	return PropertyEntity{path: "/rest/api/2/user/properties", user: user}
+}
+
+func (s *EntityPropertiesService) propertyPath(ctx context.Context, entity PropertyEntity, propertyKey string) (string, error) {
+	path := entity.path
+	if propertyKey != "" {
+		path += "/" + url.PathEscape(propertyKey)
+	}
+	if entity.user == nil {
+		return path, nil
+	}
+	param, id, err := s.client.userIdentifier(ctx, entity.user)
+	if err != nil {
+		return "", err
+	}
+	return withQuery(path, url.Values{param: {id}}), nil
+}
+
+// EntityPropertyKey represents the key of a property.
+type EntityPropertyKey struct {
+	Self string `json:"self,omitempty"`
+	Key  string `json:"key"`
+}
+
+// Keys returns the keys of the properties of an entity.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-issueidorkey-properties-get
+func (s *EntityPropertiesService) Keys(ctx context.Context, entity PropertyEntity) ([]*EntityPropertyKey, error) {
+	apiEndpoint, err := s.propertyPath(ctx, entity, "")
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Keys []*EntityPropertyKey `json:"keys"`
+	}
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Keys, nil
+}
+
+// Get decodes the value of a property of an entity into v.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-issueidorkey-properties-propertykey-get
+func (s *EntityPropertiesService) Get(ctx context.Context, entity PropertyEntity, propertyKey string, v interface{}) error {
+	apiEndpoint, err := s.propertyPath(ctx, entity, propertyKey)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &result); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(result.Value, v); err != nil {
+		return fmt.Errorf("decode property %s: %w", propertyKey, err)
+	}
+	return nil
+}
+
+// Set sets the value of a property of an entity, value is encoded as JSON and must not exceed 32768 bytes.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-issueidorkey-properties-propertykey-put
+func (s *EntityPropertiesService) Set(ctx context.Context, entity PropertyEntity, propertyKey string, value interface{}) error {
+	apiEndpoint, err := s.propertyPath(ctx, entity, propertyKey)
+	if err != nil {
+		return err
+	}
+	return s.client.Invoke(ctx, http.MethodPut, apiEndpoint, value, nil)
+}
+
+// Delete deletes a property of an entity.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-issueidorkey-properties-propertykey-delete
+func (s *EntityPropertiesService) Delete(ctx context.Context, entity PropertyEntity, propertyKey string) error {
+	apiEndpoint, err := s.propertyPath(ctx, entity, propertyKey)
+	if err != nil {
+		return err
+	}
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// BulkIssuePropertyFilter selects the issues of a bulk property change, all issues the user can edit are selected if empty.
+type BulkIssuePropertyFilter struct {
+	EntityIds []int64 `json:"entityIds,omitempty"`
+	// CurrentValue selects the issues whose property has this value.
+	CurrentValue interface{} `json:"currentValue,omitempty"`
+	// HasProperty selects the issues with or without the property, bulk set only.
+	HasProperty *bool `json:"hasProperty,omitempty"`
+}
+
+// BulkSetIssueProperty sets a property on all issues selected by filter.
+// Jira runs it as an asynchronous task, the properties may not be set yet when it returns.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-properties-propertykey-put
+func (s *EntityPropertiesService) BulkSetIssueProperty(ctx context.Context, propertyKey string, value interface{}, filter *BulkIssuePropertyFilter) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/properties/%s", url.PathEscape(propertyKey))
+	body := struct {
+		Value  interface{}              `json:"value"`
+		Filter *BulkIssuePropertyFilter `json:"filter,omitempty"`
+	}{Value: value, Filter: filter}
+	return s.client.Invoke(ctx, http.MethodPut, apiEndpoint, &body, nil)
+}
+
+// BulkDeleteIssueProperty deletes a property from all issues selected by filter, HasProperty is ignored.
+// Jira runs it as an asynchronous task, the properties may not be deleted yet when it returns.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-properties-propertykey-delete
+func (s *EntityPropertiesService) BulkDeleteIssueProperty(ctx context.Context, propertyKey string, filter *BulkIssuePropertyFilter) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/properties/%s", url.PathEscape(propertyKey))
+	body := struct {
+		EntityIds    []int64     `json:"entityIds,omitempty"`
+		CurrentValue interface{} `json:"currentValue,omitempty"`
+	}{}
+	if filter != nil {
+		body.EntityIds, body.CurrentValue = filter.EntityIds, filter.CurrentValue
+	}
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, &body, nil)
+}
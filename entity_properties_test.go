@@ -0,0 +1,87 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEntityPropertiesService(t *testing.T) {
+	properties := map[string]json.RawMessage{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Path + "?" + r.URL.RawQuery
+		switch r.Method {
+		case http.MethodPut:
+			var v json.RawMessage
+			_ = json.NewDecoder(r.Body).Decode(&v)
+			properties[key] = v
+		case http.MethodGet:
+			v, ok := properties[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"key": "sync", "value": v})
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, &Options{DeploymentType: CloudDeploymentType})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type syncState struct {
+		Revision int    `json:"revision"`
+		Source   string `json:"source"`
+	}
+	ctx := context.Background()
+	for _, entity := range []PropertyEntity{
+		IssueEntity("TEST-1"),
+		WorklogEntity("TEST-1", "10000"),
+		UserEntity(&User{AccountID: "5b10ac8d82e05b22cc7d4ef5", Name: "fred"}),
+	} {
+		if err := client.Property.Set(ctx, entity, "sync", &syncState{Revision: 3, Source: "crm"}); err != nil {
+			t.Fatal(err)
+		}
+		var got syncState
+		if err := client.Property.Get(ctx, entity, "sync", &got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Revision != 3 || got.Source != "crm" {
+			t.Errorf("%s: got %+v", entity.path, got)
+		}
+	}
+	if _, ok := properties["/rest/api/2/user/properties/sync?accountId=5b10ac8d82e05b22cc7d4ef5"]; !ok {
+		t.Errorf("user property not stored by accountId: %v", properties)
+	}
+}
+
+func TestEntityPropertiesService_UserEntity(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+	}))
+	defer server.Close()
+
+	user := &User{AccountID: "5b10ac8d82e05b22cc7d4ef5", Name: "fred"}
+	for _, deploymentType := range []DeploymentType{CloudDeploymentType, ServerDeploymentType} {
+		client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, &Options{DeploymentType: deploymentType})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.Property.Set(context.Background(), UserEntity(user), "sync", true); err != nil {
+			t.Fatal(err)
+		}
+		// a user without the identifier of the deployment type is not sent
+		if err := client.Property.Delete(context.Background(), UserEntity(&User{}), "sync"); err == nil {
+			t.Errorf("%s: want an error of a user without identifier", deploymentType)
+		}
+	}
+	want := []string{"accountId=5b10ac8d82e05b22cc7d4ef5", "username=fred"}
+	if len(queries) != 2 || queries[0] != want[0] || queries[1] != want[1] {
+		t.Errorf("got queries %q, want %q", queries, want)
+	}
+}
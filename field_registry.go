@@ -0,0 +1,243 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrFieldNotFound     = errors.New("field not found")
+	ErrAmbiguousField    = errors.New("ambiguous field name")
+	ErrInvalidFieldValue = errors.New("invalid field value")
+)
+
+// FieldRegistry caches the fields of the Jira instance, loaded by IssuesService.GetFields on first use,
+// to resolve a field by id, display name or JQL clause name.
+// The cache is kept until Refresh or Invalidate is called.
+type FieldRegistry struct {
+	client *Client
+
+	mu       sync.RWMutex
+	fields   []*Field
+	byID     map[string]*Field
+	byName   map[string][]*Field
+	byClause map[string]*Field
+}
+
+// Refresh reloads the fields.
+func (r *FieldRegistry) Refresh(ctx context.Context) error {
+	fields, err := r.client.Issue.GetFields(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*Field, len(fields))
+	byName := make(map[string][]*Field, len(fields))
+	byClause := make(map[string]*Field, len(fields))
+	for _, field := range fields {
+		if field == nil {
+			continue
+		}
+		byID[field.ID] = field
+		name := strings.ToLower(field.Name)
+		byName[name] = append(byName[name], field)
+		for _, clause := range field.ClauseNames {
+			byClause[strings.ToLower(clause)] = field
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fields, r.byID, r.byName, r.byClause = fields, byID, byName, byClause
+	return nil
+}
+
+// Invalidate drops the cache, the fields are loaded again on next use.
+func (r *FieldRegistry) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fields, r.byID, r.byName, r.byClause = nil, nil, nil, nil
+}
+
+func (r *FieldRegistry) load(ctx context.Context) error {
+	r.mu.RLock()
+	loaded := r.byID != nil
+	r.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+	return r.Refresh(ctx)
+}
+
+// Fields returns all fields.
+func (r *FieldRegistry) Fields(ctx context.Context) ([]*Field, error) {
+	if err := r.load(ctx); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]*Field(nil), r.fields...), nil
+}
+
+// Field returns the field by id, e.g. `customfield_10016`, by display name, e.g. `Story Points`,
+// or by JQL clause name, e.g. `cf[10016]`. Names are case-insensitive,
+// ErrAmbiguousField is returned if several fields have the display name.
+func (r *FieldRegistry) Field(ctx context.Context, idOrName string) (*Field, error) {
+	if err := r.load(ctx); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if field, ok := r.byID[idOrName]; ok {
+		return field, nil
+	}
+	key := strings.ToLower(idOrName)
+	switch fields := r.byName[key]; len(fields) {
+	case 0:
+	case 1:
+		return fields[0], nil
+	default:
+		ids := make([]string, 0, len(fields))
+		for _, field := range fields {
+			ids = append(ids, field.ID)
+		}
+		return nil, fmt.Errorf("%w: %q is the name of %s", ErrAmbiguousField, idOrName, strings.Join(ids, ", "))
+	}
+	if field, ok := r.byClause[key]; ok {
+		return field, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrFieldNotFound, idOrName)
+}
+
+// ID returns the id of the field, e.g. `customfield_10016` of `Story Points`.
+func (r *FieldRegistry) ID(ctx context.Context, name string) (string, error) {
+	field, err := r.Field(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	return field.ID, nil
+}
+
+// Name returns the display name of the field, e.g. `Story Points` of `customfield_10016`.
+func (r *FieldRegistry) Name(ctx context.Context, id string) (string, error) {
+	field, err := r.Field(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return field.Name, nil
+}
+
+// ClauseName returns the JQL clause name of the field, `cf[10016]` is preferred for the custom fields
+// because the display name may be ambiguous.
+func (r *FieldRegistry) ClauseName(ctx context.Context, idOrName string) (string, error) {
+	field, err := r.Field(ctx, idOrName)
+	if err != nil {
+		return "", err
+	}
+	if len(field.ClauseNames) == 0 {
+		return "", fmt.Errorf("%w: %q is not searchable", ErrFieldNotFound, idOrName)
+	}
+	for _, clause := range field.ClauseNames {
+		if strings.HasPrefix(clause, "cf[") {
+			return clause, nil
+		}
+	}
+	return field.ClauseNames[0], nil
+}
+
+// Validate checks that the JSON encoding of value matches the schema of the field, before it is sent by Create or Update.
+// The field types not known by the registry are accepted.
+func (r *FieldRegistry) Validate(ctx context.Context, idOrName string, value interface{}) error {
+	field, err := r.Field(ctx, idOrName)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return validateFieldValue(field, raw)
+}
+
+// ValidateFields validates the custom fields of fields, see Validate.
+func (r *FieldRegistry) ValidateFields(ctx context.Context, fields *IssueFields) error {
+	if fields == nil {
+		return nil
+	}
+	for key, raw := range fields.Unknowns {
+		field, err := r.Field(ctx, key)
+		if err != nil {
+			return err
+		}
+		if err := validateFieldValue(field, raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateFieldValue(field *Field, raw json.RawMessage) error {
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return err
+	}
+	// null clears the field
+	if v == nil {
+		return nil
+	}
+	// the sprint field is an array of sprints but is set by a single sprint id
+	if _, ok := v.(float64); ok && strings.HasSuffix(field.Schema.Custom, ":gh-sprint") {
+		return nil
+	}
+	if err := validateSchemaType(field.Schema.Type, field.Schema.Items, v); err != nil {
+		return fmt.Errorf("%w: %s (%s): %v", ErrInvalidFieldValue, field.Name, field.ID, err)
+	}
+	return nil
+}
+
+func validateSchemaType(schemaType, items string, v interface{}) error {
+	switch schemaType {
+	case "string":
+		if _, ok := v.(string); !ok {
+			return fmt.Errorf("expected a string, got %T", v)
+		}
+	case "number":
+		if _, ok := v.(float64); !ok {
+			return fmt.Errorf("expected a number, got %T", v)
+		}
+	case "date", "datetime":
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("expected a %s string, got %T", schemaType, v)
+		}
+		if _, _, err := parseTime([]byte(fmt.Sprintf("%q", s))); err != nil {
+			return err
+		}
+	case "array":
+		values, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected an array, got %T", v)
+		}
+		for _, item := range values {
+			if err := validateSchemaType(items, "", item); err != nil {
+				return err
+			}
+		}
+	case "user":
+		user, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected a user object, got %T", v)
+		}
+		if user["accountId"] == nil && user["name"] == nil && user["key"] == nil {
+			return fmt.Errorf("expected a user with accountId or name")
+		}
+	case "option", "option-with-child", "priority", "version", "component", "project", "issuetype", "resolution", "group":
+		if _, ok := v.(map[string]interface{}); !ok {
+			return fmt.Errorf("expected a JSON object of %s, got %T", schemaType, v)
+		}
+	}
+	return nil
+}
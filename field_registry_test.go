@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFieldRegistry(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_ = json.NewEncoder(w).Encode([]*Field{
+			{ID: "summary", Name: "Summary", ClauseNames: []string{"summary"}, Schema: FieldSchema{Type: "string", System: "summary"}},
+			{ID: "customfield_10016", Name: "Story Points", Custom: true, ClauseNames: []string{"cf[10016]", "Story Points"}, Schema: FieldSchema{Type: "number"}},
+			{ID: "customfield_10020", Name: "Sprint", Custom: true, ClauseNames: []string{"cf[10020]", "Sprint"}, Schema: FieldSchema{Type: "array", Items: "json", Custom: "com.pyxis.greenhopper.jira:gh-sprint"}},
+			{ID: "customfield_10030", Name: "Team", Custom: true, Schema: FieldSchema{Type: "option"}},
+			{ID: "customfield_10031", Name: "Team", Custom: true, Schema: FieldSchema{Type: "string"}},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if id, err := client.Fields.ID(ctx, "story points"); err != nil || id != "customfield_10016" {
+		t.Errorf("ID: got %s, %v", id, err)
+	}
+	if name, err := client.Fields.Name(ctx, "customfield_10016"); err != nil || name != "Story Points" {
+		t.Errorf("Name: got %s, %v", name, err)
+	}
+	if clause, err := client.Fields.ClauseName(ctx, "Story Points"); err != nil || clause != "cf[10016]" {
+		t.Errorf("ClauseName: got %s, %v", clause, err)
+	}
+	if _, err := client.Fields.Field(ctx, "Team"); !errors.Is(err, ErrAmbiguousField) {
+		t.Errorf("ambiguous: got %v", err)
+	}
+	if _, err := client.Fields.Field(ctx, "Missing"); !errors.Is(err, ErrFieldNotFound) {
+		t.Errorf("missing: got %v", err)
+	}
+
+	if err := client.Fields.Validate(ctx, "Story Points", "five"); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("invalid number: got %v", err)
+	}
+	fields := &IssueFields{}
+	_ = fields.SetCustomNumber("customfield_10016", 5)
+	_ = fields.SetCustomSprint("customfield_10020", 1)
+	if err := client.Fields.ValidateFields(ctx, fields); err != nil {
+		t.Errorf("ValidateFields: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("fields loaded %d times, want 1", calls)
+	}
+
+	client.Fields.Invalidate()
+	if _, err := client.Fields.Fields(ctx); err != nil || calls != 2 {
+		t.Errorf("after Invalidate: %d calls, %v", calls, err)
+	}
+}
+
+func TestFieldRegistry_SetCredential(t *testing.T) {
+	newServer := func(id string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode([]*Field{
+				{ID: id, Name: "Story Points", Custom: true, ClauseNames: []string{"Story Points"}, Schema: FieldSchema{Type: "number"}},
+			})
+		}))
+	}
+	first, second := newServer("customfield_10016"), newServer("customfield_10100")
+	defer first.Close()
+	defer second.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: first.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if id, err := client.Fields.ID(ctx, "Story Points"); err != nil || id != "customfield_10016" {
+		t.Fatalf("ID: got %s, %v", id, err)
+	}
+	if err := client.SetCredential(&BasicAuth{Endpoint: second.URL, Username: "u", Password: "p"}); err != nil {
+		t.Fatal(err)
+	}
+	if id, err := client.Fields.ID(ctx, "Story Points"); err != nil || id != "customfield_10100" {
+		t.Errorf("ID after SetCredential: got %s, %v, want the field of the new instance", id, err)
+	}
+}
@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// AutomaticAssignee assigns the issue to the default assignee of the project.
+const AutomaticAssignee = "-1"
+
+// Assign assigns the issue to the user, identified by AccountID on Cloud and by Name on Server and Data Center.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-assignee-put
+func (s *IssuesService) Assign(ctx context.Context, issueIdOrKey string, user *User) error {
+	param, id, err := s.client.userIdentifier(ctx, user)
+	if err != nil {
+		return err
+	}
+	if param == "username" {
+		param = "name"
+	}
+	return s.assign(ctx, issueIdOrKey, map[string]interface{}{param: id})
+}
+
+// Unassign removes the assignee of the issue.
+func (s *IssuesService) Unassign(ctx context.Context, issueIdOrKey string) error {
+	field, err := s.assigneeField(ctx)
+	if err != nil {
+		return err
+	}
+	return s.assign(ctx, issueIdOrKey, map[string]interface{}{field: nil})
+}
+
+// AssignAutomatic assigns the issue to the default assignee of the project, see AutomaticAssignee.
+func (s *IssuesService) AssignAutomatic(ctx context.Context, issueIdOrKey string) error {
+	field, err := s.assigneeField(ctx)
+	if err != nil {
+		return err
+	}
+	return s.assign(ctx, issueIdOrKey, map[string]interface{}{field: AutomaticAssignee})
+}
+
+// assigneeField returns the field identifying the assignee, `accountId` on Cloud and `name` on Server.
+func (s *IssuesService) assigneeField(ctx context.Context) (string, error) {
+	deploymentType, err := s.client.DeploymentType(ctx)
+	if err != nil {
+		return "", err
+	}
+	if deploymentType == CloudDeploymentType {
+		return "accountId", nil
+	}
+	return "name", nil
+}
+
+func (s *IssuesService) assign(ctx context.Context, issueIdOrKey string, body map[string]interface{}) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/assignee", issueIdOrKey)
+	return s.client.Invoke(ctx, http.MethodPut, apiEndpoint, body, nil)
+}
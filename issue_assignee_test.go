@@ -0,0 +1,57 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIssuesService_Assign(t *testing.T) {
+	tests := []struct {
+		deploymentType DeploymentType
+		want           []string
+	}{
+		{deploymentType: CloudDeploymentType, want: []string{`{"accountId":"5b10ac8d82e05b22cc7d4ef5"}`, `{"accountId":null}`, `{"accountId":"-1"}`}},
+		{deploymentType: ServerDeploymentType, want: []string{`{"name":"fred"}`, `{"name":null}`, `{"name":"-1"}`}},
+	}
+	for _, tt := range tests {
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/rest/api/2/issue/TEST-1/assignee" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			b, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, &Options{
+			DeploymentType: tt.deploymentType,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := context.Background()
+		if err := client.Issue.Assign(ctx, "TEST-1", &User{AccountID: "5b10ac8d82e05b22cc7d4ef5", Name: "fred"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := client.Issue.Unassign(ctx, "TEST-1"); err != nil {
+			t.Fatal(err)
+		}
+		if err := client.Issue.AssignAutomatic(ctx, "TEST-1"); err != nil {
+			t.Fatal(err)
+		}
+		server.Close()
+
+		if len(bodies) != len(tt.want) {
+			t.Fatalf("%s: got bodies %v", tt.deploymentType, bodies)
+		}
+		for i := range tt.want {
+			if bodies[i] != tt.want[i] {
+				t.Errorf("%s: got body %s, want %s", tt.deploymentType, bodies[i], tt.want[i])
+			}
+		}
+	}
+}
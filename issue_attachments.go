@@ -1,14 +1,242 @@
 package jira
 
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"strings"
+)
+
 // Attachment represents a Jira attachment
 type Attachment struct {
 	Self      string `json:"self,omitempty" structs:"self,omitempty"`
 	ID        string `json:"id,omitempty" structs:"id,omitempty"`
 	Filename  string `json:"filename,omitempty" structs:"filename,omitempty"`
 	Author    *User  `json:"author,omitempty" structs:"author,omitempty"`
-	Created   string `json:"created,omitempty" structs:"created,omitempty"`
+	Created   *Time  `json:"created,omitempty" structs:"created,omitempty"`
 	Size      int    `json:"size,omitempty" structs:"size,omitempty"`
 	MimeType  string `json:"mimeType,omitempty" structs:"mimeType,omitempty"`
 	Content   string `json:"content,omitempty" structs:"content,omitempty"`
 	Thumbnail string `json:"thumbnail,omitempty" structs:"thumbnail,omitempty"`
 }
+
+// AttachmentSettings represents the attachment settings of the Jira instance.
+type AttachmentSettings struct {
+	Enabled bool `json:"enabled"`
+	// UploadLimit: The maximum size of an attachment in bytes.
+	UploadLimit int64 `json:"uploadLimit"`
+}
+
+// GetAttachmentSettings returns the attachment settings, i.e. whether attachments are enabled and the maximum attachment size.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-attachment-meta-get
+func (s *IssuesService) GetAttachmentSettings(ctx context.Context) (*AttachmentSettings, error) {
+	const apiEndpoint = "/rest/api/2/attachment/meta"
+	var settings AttachmentSettings
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// GetAttachment returns the metadata of an attachment.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-attachment-id-get
+func (s *IssuesService) GetAttachment(ctx context.Context, id string) (*Attachment, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/attachment/%s", id)
+	var attachment Attachment
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &attachment); err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// DeleteAttachment deletes an attachment from an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-attachment-id-delete
+func (s *IssuesService) DeleteAttachment(ctx context.Context, id string) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/attachment/%s", id)
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// AddAttachment uploads the content of r as an attachment of an issue, r is streamed without being buffered.
+// mimeType defaults to application/octet-stream.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-issue-issueidorkey-attachments-post
+func (s *IssuesService) AddAttachment(ctx context.Context, issueIdOrKey, filename, mimeType string, r io.Reader) ([]*Attachment, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/attachments", issueIdOrKey)
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		part, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Disposition": {fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(filename))},
+			"Content-Type":        {mimeType},
+		})
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		_ = pw.CloseWithError(err)
+	}()
+
+	resp, err := s.client.do(ctx, http.MethodPost, apiEndpoint, pr, http.Header{
+		"Content-Type": {mw.FormDataContentType()},
+		// Jira blocks the multipart requests without it to prevent XSRF
+		"X-Atlassian-Token": {"no-check"},
+	})
+	// unblock the writer if the request failed before the body was read
+	_ = pr.Close()
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var attachments []*Attachment
+	if err := json.NewDecoder(resp.Body).Decode(&attachments); err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// DownloadAttachment writes the content of an attachment to w without buffering, and returns the number of bytes written.
+func (s *IssuesService) DownloadAttachment(ctx context.Context, attachment *Attachment, w io.Writer) (int64, error) {
+	if attachment == nil || attachment.Content == "" {
+		return 0, fmt.Errorf("attachment has no content url")
+	}
+	return s.download(ctx, attachment.Content, w)
+}
+
+// DownloadAttachmentThumbnail writes the thumbnail of an image attachment to w, and returns the number of bytes written.
+func (s *IssuesService) DownloadAttachmentThumbnail(ctx context.Context, attachment *Attachment, w io.Writer) (int64, error) {
+	if attachment == nil || attachment.Thumbnail == "" {
+		return 0, fmt.Errorf("attachment has no thumbnail url")
+	}
+	return s.download(ctx, attachment.Thumbnail, w)
+}
+
+func (s *IssuesService) download(ctx context.Context, contentURL string, w io.Writer) (int64, error) {
+	resp, err := s.client.do(ctx, http.MethodGet, contentURL, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return io.Copy(w, resp.Body)
+}
+
+// AttachmentArchiveEntry represents an entry of a zip or jar attachment listed by ExpandAttachmentHuman.
+type AttachmentArchiveEntry struct {
+	Index int    `json:"index"`
+	Path  string `json:"path"`
+	// Size: The human readable size, e.g. `2 kB`.
+	Size      string `json:"size"`
+	MediaType string `json:"mediaType"`
+	Label     string `json:"label"`
+}
+
+// AttachmentArchive represents the entries of a zip or jar attachment in human readable form.
+type AttachmentArchive struct {
+	ID              int                       `json:"id"`
+	Name            string                    `json:"name"`
+	Entries         []*AttachmentArchiveEntry `json:"entries"`
+	TotalEntryCount int                       `json:"totalEntryCount"`
+	MediaType       string                    `json:"mediaType"`
+}
+
+// AttachmentArchiveRawEntry represents an entry of a zip or jar attachment listed by ExpandAttachmentRaw.
+type AttachmentArchiveRawEntry struct {
+	EntryIndex      int    `json:"entryIndex"`
+	Name            string `json:"name"`
+	AbbreviatedName string `json:"abbreviatedName"`
+	// Size: The size in bytes.
+	Size      int64  `json:"size"`
+	MediaType string `json:"mediaType"`
+}
+
+// AttachmentArchiveRaw represents the entries of a zip or jar attachment.
+type AttachmentArchiveRaw struct {
+	Entries         []*AttachmentArchiveRawEntry `json:"entries"`
+	TotalEntryCount int                          `json:"totalEntryCount"`
+}
+
+// ExpandAttachmentHuman returns the entries of a zip or jar attachment with human readable sizes.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-attachment-id-expand-human-get
+func (s *IssuesService) ExpandAttachmentHuman(ctx context.Context, id string) (*AttachmentArchive, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/attachment/%s/expand/human", id)
+	var archive AttachmentArchive
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &archive); err != nil {
+		return nil, err
+	}
+	return &archive, nil
+}
+
+// ExpandAttachmentRaw returns the entries of a zip or jar attachment with sizes in bytes.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-attachment-id-expand-raw-get
+func (s *IssuesService) ExpandAttachmentRaw(ctx context.Context, id string) (*AttachmentArchiveRaw, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/attachment/%s/expand/raw", id)
+	var archive AttachmentArchiveRaw
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &archive); err != nil {
+		return nil, err
+	}
+	return &archive, nil
+}
+
+type ExtractAttachmentEntryOptions struct {
+	// TempDir is the directory of the temporary file of the archive. Default: os.TempDir()
+	TempDir string
+}
+
+// ExtractAttachmentEntry writes the content of an entry of a zip or jar attachment to w, and returns the number of
+// bytes written. The entry is one of the entries listed by ExpandAttachmentRaw, it is found by its EntryIndex.
+//
+// Jira has no API to read an entry, so the whole archive is downloaded to a temporary file in
+// ExtractAttachmentEntryOptions.TempDir, which takes Attachment.Size bytes of disk until it is removed on return.
+func (s *IssuesService) ExtractAttachmentEntry(ctx context.Context, attachment *Attachment, entry *AttachmentArchiveRawEntry, w io.Writer, opts ...*ExtractAttachmentEntryOptions) (int64, error) {
+	if entry == nil {
+		return 0, fmt.Errorf("nil attachment entry")
+	}
+	var tempDir string
+	if len(opts) > 0 && opts[0] != nil {
+		tempDir = opts[0].TempDir
+	}
+	f, err := os.CreateTemp(tempDir, "jira-attachment-*.zip")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	size, err := s.DownloadAttachment(ctx, attachment, f)
+	if err != nil {
+		return 0, err
+	}
+	archive, err := zip.NewReader(f, size)
+	if err != nil {
+		return 0, err
+	}
+	// the name is checked, in case the attachment is not the one listed
+	if entry.EntryIndex < 0 || entry.EntryIndex >= len(archive.File) || archive.File[entry.EntryIndex].Name != entry.Name {
+		return 0, fmt.Errorf("entry %d %q not found in attachment %s", entry.EntryIndex, entry.Name, attachment.Filename)
+	}
+	rc, err := archive.File[entry.EntryIndex].Open()
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+	return io.Copy(w, rc)
+}
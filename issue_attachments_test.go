@@ -0,0 +1,125 @@
+package jira
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestIssuesService_AddAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Atlassian-Token") != "no-check" {
+			t.Error("missing X-Atlassian-Token header")
+		}
+		if _, _, ok := r.BasicAuth(); !ok {
+			t.Error("missing basic auth")
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := io.ReadAll(file)
+		_ = json.NewEncoder(w).Encode([]*Attachment{{
+			ID:       "10000",
+			Filename: header.Filename,
+			MimeType: header.Header.Get("Content-Type"),
+			Size:     len(b),
+		}})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attachments, err := client.Issue.AddAttachment(context.Background(), "TEST-1", "build.log", "text/plain", strings.NewReader("build ok"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attachments) != 1 || attachments[0].Filename != "build.log" || attachments[0].MimeType != "text/plain" || attachments[0].Size != 8 {
+		t.Fatalf("unexpected attachments: %+v", attachments[0])
+	}
+}
+
+func TestIssuesService_DownloadAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/attachment/content/10000" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages":["not found"]}`))
+			return
+		}
+		_, _ = w.Write([]byte("build ok"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	n, err := client.Issue.DownloadAttachment(context.Background(), &Attachment{Content: server.URL + "/rest/api/2/attachment/content/10000"}, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 8 || buf.String() != "build ok" {
+		t.Fatalf("got %d bytes: %q", n, buf.String())
+	}
+
+	_, err = client.Issue.DownloadAttachment(context.Background(), &Attachment{Content: "https://other.example.com/rest/api/2/attachment/content/1"}, &buf)
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found from the endpoint host, got %v", err)
+	}
+}
+
+func TestIssuesService_ExtractAttachmentEntry(t *testing.T) {
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for _, entry := range []struct{ name, content string }{{"bin/app", "binary"}, {"VERSION", "1.2.3"}} {
+		w, err := zw.Create(entry.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = w.Write([]byte(entry.content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive.Bytes())
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tempDir := t.TempDir()
+	attachment := &Attachment{Filename: "bundle.zip", Content: server.URL + "/rest/api/2/attachment/content/10000"}
+	opts := &ExtractAttachmentEntryOptions{TempDir: tempDir}
+	var buf bytes.Buffer
+	if _, err := client.Issue.ExtractAttachmentEntry(context.Background(), attachment, &AttachmentArchiveRawEntry{EntryIndex: 1, Name: "VERSION"}, &buf, opts); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "1.2.3" {
+		t.Fatalf("got %q", buf.String())
+	}
+	for _, entry := range []*AttachmentArchiveRawEntry{{EntryIndex: 2, Name: "VERSION"}, {EntryIndex: 0, Name: "VERSION"}, nil} {
+		if _, err := client.Issue.ExtractAttachmentEntry(context.Background(), attachment, entry, &buf, opts); err == nil {
+			t.Errorf("%+v: expected an error for a missing entry", entry)
+		}
+	}
+	if files, err := os.ReadDir(tempDir); err != nil || len(files) != 0 {
+		t.Errorf("got temporary files %v, %v, want them removed", files, err)
+	}
+}
@@ -0,0 +1,197 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// MaxBulkCreateIssues is the number of issues Jira creates by one bulk request.
+	MaxBulkCreateIssues = 50
+	// DefaultBulkCreateConcurrency is the number of bulk requests sent at the same time.
+	DefaultBulkCreateConcurrency = 4
+)
+
+type CreateBulkOptions struct {
+	// Concurrency is the number of bulk requests sent at the same time. Default: DefaultBulkCreateConcurrency
+	Concurrency int
+}
+
+// BulkCreateError represents an issue of CreateBulk which is not created.
+type BulkCreateError struct {
+	// Index of the issue in the issues passed to CreateBulk.
+	Index int
+	// Status is the HTTP status of the element, 0 if the request of the chunk failed.
+	Status        int
+	ErrorMessages []string
+	// Errors are the errors by field, e.g. `summary`.
+	Errors map[string]string
+	// Err is the error of the request of the chunk, e.g. a network error,
+	// or the error of the context if the chunk was not sent.
+	Err error
+}
+
+func (e *BulkCreateError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("issue %d: %v", e.Index, e.Err)
+	}
+	messages := append([]string(nil), e.ErrorMessages...)
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		messages = append(messages, field+": "+e.Errors[field])
+	}
+	return fmt.Sprintf("issue %d: %d: %s", e.Index, e.Status, strings.Join(messages, ", "))
+}
+
+func (e *BulkCreateError) Unwrap() error {
+	return e.Err
+}
+
+// BulkCreateResult represents the result of CreateBulk.
+type BulkCreateResult struct {
+	// Issues are the created issues by index of the issues passed to CreateBulk, nil if not created.
+	Issues []*Issue
+	// Errors of the issues not created, ordered by Index.
+	Errors []*BulkCreateError
+}
+
+type bulkCreateResponse struct {
+	Issues []*Issue `json:"issues"`
+	Errors []struct {
+		Status        int `json:"status"`
+		ElementErrors struct {
+			ErrorMessages []string          `json:"errorMessages"`
+			Errors        map[string]string `json:"errors"`
+		} `json:"elementErrors"`
+		FailedElementNumber int `json:"failedElementNumber"`
+	} `json:"errors"`
+}
+
+// CreateBulk creates the issues by chunks of MaxBulkCreateIssues, the chunks are sent concurrently.
+// An issue which is not created does not abort the others, it is reported in BulkCreateResult.Errors.
+// The error is returned if the request of a whole chunk failed, e.g. 401 or a network error, or if ctx is done.
+// The result then still holds the issues created so far, and an error for every other issue,
+// including the issues of the chunks which were not sent.
+// UpdateHistory of the issues is ignored.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-bulk-post
+func (s *IssuesService) CreateBulk(ctx context.Context, issues []*CreateIssueOptions, opts ...*CreateBulkOptions) (*BulkCreateResult, error) {
+	concurrency := DefaultBulkCreateConcurrency
+	if len(opts) > 0 && opts[0] != nil && opts[0].Concurrency > 0 {
+		concurrency = opts[0].Concurrency
+	}
+
+	result := &BulkCreateResult{Issues: make([]*Issue, len(issues))}
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+		// the error of the first chunk whose request failed
+		chunkErr      error
+		chunkErrStart int
+	)
+	for start := 0; start < len(issues); start += MaxBulkCreateIssues {
+		end := start + MaxBulkCreateIssues
+		if end > len(issues) {
+			end = len(issues)
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			mu.Lock()
+			for i := start; i < len(issues); i++ {
+				result.Errors = append(result.Errors, &BulkCreateError{Index: i, Err: ctx.Err()})
+			}
+			mu.Unlock()
+			break
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			created, errs, err := s.createChunk(ctx, issues[start:end], start)
+			mu.Lock()
+			defer mu.Unlock()
+			copy(result.Issues[start:end], created)
+			result.Errors = append(result.Errors, errs...)
+			if err != nil && (chunkErr == nil || start < chunkErrStart) {
+				chunkErr = fmt.Errorf("bulk create issues %d to %d: %w", start, end-1, err)
+				chunkErrStart = start
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	sort.Slice(result.Errors, func(i, j int) bool {
+		return result.Errors[i].Index < result.Errors[j].Index
+	})
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+	return result, chunkErr
+}
+
+// createChunk creates at most MaxBulkCreateIssues issues, offset is the index of the first issue.
+// The error is returned if the request failed as a whole, rather than by the errors of its elements.
+func (s *IssuesService) createChunk(ctx context.Context, issues []*CreateIssueOptions, offset int) ([]*Issue, []*BulkCreateError, error) {
+	const apiEndpoint = "/rest/api/2/issue/bulk"
+	body := struct {
+		IssueUpdates []*CreateIssueOptions `json:"issueUpdates"`
+	}{IssueUpdates: issues}
+
+	var resp bulkCreateResponse
+	err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, &body, &resp)
+	if err != nil {
+		// Jira responds 400 with the errors of the elements if no issue is created
+		var apiErr *Error
+		if !errors.As(err, &apiErr) || json.Unmarshal(apiErr.ErrorsRaw, &resp.Errors) != nil || len(resp.Errors) == 0 {
+			errs := make([]*BulkCreateError, len(issues))
+			for i := range issues {
+				errs[i] = &BulkCreateError{Index: offset + i, Err: err}
+			}
+			return nil, errs, err
+		}
+		resp.Issues = nil
+	}
+
+	created := make([]*Issue, len(issues))
+	failed := make(map[int]bool, len(resp.Errors))
+	errs := make([]*BulkCreateError, 0, len(resp.Errors))
+	for _, e := range resp.Errors {
+		failed[e.FailedElementNumber] = true
+		errs = append(errs, &BulkCreateError{
+			Index:         offset + e.FailedElementNumber,
+			Status:        e.Status,
+			ErrorMessages: e.ElementErrors.ErrorMessages,
+			Errors:        e.ElementErrors.Errors,
+		})
+	}
+	// the created issues are returned in the order of the request, without the failed elements
+	next := 0
+	for i := range issues {
+		if failed[i] {
+			continue
+		}
+		if next < len(resp.Issues) {
+			created[i] = resp.Issues[next]
+			next++
+		} else if err != nil {
+			errs = append(errs, &BulkCreateError{Index: offset + i, Err: err})
+		}
+	}
+	return created, errs, nil
+}
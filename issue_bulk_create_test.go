@@ -0,0 +1,233 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestIssuesService_CreateBulk(t *testing.T) {
+	var running, maxRunning int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+
+		var body struct {
+			IssueUpdates []*CreateIssueOptions `json:"issueUpdates"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		if len(body.IssueUpdates) > MaxBulkCreateIssues {
+			t.Errorf("got %d issues in a chunk", len(body.IssueUpdates))
+		}
+		type elementError struct {
+			Status        int `json:"status"`
+			ElementErrors struct {
+				Errors map[string]string `json:"errors"`
+			} `json:"elementErrors"`
+			FailedElementNumber int `json:"failedElementNumber"`
+		}
+		var resp struct {
+			Issues []*Issue        `json:"issues"`
+			Errors []*elementError `json:"errors"`
+		}
+		for i, update := range body.IssueUpdates {
+			if update.Fields.Summary == "bad" {
+				e := &elementError{Status: 400, FailedElementNumber: i}
+				e.ElementErrors.Errors = map[string]string{"summary": "invalid"}
+				resp.Errors = append(resp.Errors, e)
+				continue
+			}
+			resp.Issues = append(resp.Issues, &Issue{Key: update.Fields.Summary})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&resp)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues := make([]*CreateIssueOptions, 120)
+	for i := range issues {
+		summary := "TEST-" + strconv.Itoa(i)
+		if i == 7 || i == 60 {
+			summary = "bad"
+		}
+		issues[i] = &CreateIssueOptions{Fields: &IssueFields{Summary: summary}}
+	}
+
+	result, err := client.Issue.CreateBulk(context.Background(), issues, &CreateBulkOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if maxRunning > 2 {
+		t.Errorf("got %d concurrent requests, want at most 2", maxRunning)
+	}
+	if len(result.Errors) != 2 || result.Errors[0].Index != 7 || result.Errors[1].Index != 60 {
+		t.Fatalf("got errors %v", result.Errors)
+	}
+	if got := result.Errors[1].Error(); got != "issue 60: 400: summary: invalid" {
+		t.Errorf("got error %q", got)
+	}
+	for i, issue := range result.Issues {
+		switch {
+		case i == 7 || i == 60:
+			if issue != nil {
+				t.Errorf("issue %d: got %s, want nil", i, issue.Key)
+			}
+		case issue == nil || issue.Key != "TEST-"+strconv.Itoa(i):
+			t.Errorf("issue %d: got %v", i, issue)
+		}
+	}
+}
+
+func TestIssuesService_CreateBulk_AllFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"issues":[],"errors":[` +
+			`{"status":400,"elementErrors":{"errorMessages":[],"errors":{"summary":"You must specify a summary of the issue."}},"failedElementNumber":0},` +
+			`{"status":400,"elementErrors":{"errorMessages":["Project is required"],"errors":{"project":"project is required"}},"failedElementNumber":1}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := client.Issue.CreateBulk(context.Background(), []*CreateIssueOptions{
+		{Fields: &IssueFields{}},
+		{Fields: &IssueFields{Summary: "no project"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Issues) != 2 || result.Issues[0] != nil || result.Issues[1] != nil {
+		t.Errorf("got issues %v", result.Issues)
+	}
+	if len(result.Errors) != 2 {
+		t.Fatalf("got errors %v", result.Errors)
+	}
+	first, second := result.Errors[0], result.Errors[1]
+	if first.Index != 0 || first.Status != http.StatusBadRequest || first.Err != nil ||
+		first.Errors["summary"] != "You must specify a summary of the issue." {
+		t.Errorf("got error %+v", first)
+	}
+	if second.Index != 1 || second.Errors["project"] != "project is required" ||
+		len(second.ErrorMessages) != 1 || second.ErrorMessages[0] != "Project is required" {
+		t.Errorf("got error %+v", second)
+	}
+}
+
+func bulkCreateHandler(t *testing.T, fail func(body []*CreateIssueOptions) bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			IssueUpdates []*CreateIssueOptions `json:"issueUpdates"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		if fail(body.IssueUpdates) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errorMessages":["You are not authenticated."],"errors":{}}`))
+			return
+		}
+		var resp struct {
+			Issues []*Issue `json:"issues"`
+		}
+		for _, update := range body.IssueUpdates {
+			resp.Issues = append(resp.Issues, &Issue{Key: update.Fields.Summary})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&resp)
+	}
+}
+
+func testBulkIssues(n int) []*CreateIssueOptions {
+	issues := make([]*CreateIssueOptions, n)
+	for i := range issues {
+		issues[i] = &CreateIssueOptions{Fields: &IssueFields{Summary: "TEST-" + strconv.Itoa(i)}}
+	}
+	return issues
+}
+
+func TestIssuesService_CreateBulk_ChunkFailed(t *testing.T) {
+	server := httptest.NewServer(bulkCreateHandler(t, func(body []*CreateIssueOptions) bool {
+		return body[0].Fields.Summary == "TEST-50"
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := client.Issue.CreateBulk(context.Background(), testBulkIssues(120))
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("got %v, want ErrUnauthorized", err)
+	}
+	if len(result.Errors) != MaxBulkCreateIssues {
+		t.Fatalf("got %d errors, want %d", len(result.Errors), MaxBulkCreateIssues)
+	}
+	for i, e := range result.Errors {
+		if e.Index != 50+i || !errors.Is(e, ErrUnauthorized) {
+			t.Errorf("got error %v, want issue %d unauthorized", e, 50+i)
+		}
+	}
+	for i, issue := range result.Issues {
+		if (i >= 50 && i < 100) != (issue == nil) {
+			t.Errorf("issue %d: got %v", i, issue)
+		}
+	}
+}
+
+func TestIssuesService_CreateBulk_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var requests int32
+	server := httptest.NewServer(bulkCreateHandler(t, func([]*CreateIssueOptions) bool {
+		atomic.AddInt32(&requests, 1)
+		cancel()
+		return false
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := client.Issue.CreateBulk(ctx, testBulkIssues(120), &CreateBulkOptions{Concurrency: 1})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+	if requests != 1 {
+		t.Errorf("got %d requests, want 1", requests)
+	}
+	// every issue is either created or reported, the chunks which were not sent included
+	reported := make(map[int]*BulkCreateError, len(result.Errors))
+	for _, e := range result.Errors {
+		reported[e.Index] = e
+	}
+	for i, issue := range result.Issues {
+		if (issue == nil) == (reported[i] == nil) {
+			t.Errorf("issue %d: got issue %v and error %v", i, issue, reported[i])
+		}
+		if i >= MaxBulkCreateIssues && (reported[i] == nil || !errors.Is(reported[i], context.Canceled)) {
+			t.Errorf("issue %d: got error %v, want context.Canceled", i, reported[i])
+		}
+	}
+}
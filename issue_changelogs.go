@@ -0,0 +1,104 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ListChangelogs returns a page of the changelog of an issue, oldest first.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-changelog-get
+func (s *IssuesService) ListChangelogs(ctx context.Context, issueIdOrKey string, opts *SearchOptions) (*Pagination[ChangelogHistory], error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/changelog", issueIdOrKey)
+	var result Pagination[ChangelogHistory]
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, opts, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListChangelogsPager returns a Pager over the whole changelog of an issue.
+func (s *IssuesService) ListChangelogsPager(issueIdOrKey string, pagerOpts *PagerOptions) *Pager[ChangelogHistory] {
+	return NewPager(func(ctx context.Context, startAt, maxResults int) (*Pagination[ChangelogHistory], error) {
+		return s.ListChangelogs(ctx, issueIdOrKey, pageSearchOptions(nil, startAt, maxResults))
+	}, pagerOpts)
+}
+
+// GetChangelogsByIDs returns the changelog histories of an issue by id.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-changelog-list-post
+func (s *IssuesService) GetChangelogsByIDs(ctx context.Context, issueIdOrKey string, changelogIds []int) (*Changelog, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/changelog/list", issueIdOrKey)
+	body := struct {
+		ChangelogIds []int `json:"changelogIds"`
+	}{ChangelogIds: changelogIds}
+	var changelog Changelog
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, &body, &changelog); err != nil {
+		return nil, err
+	}
+	return &changelog, nil
+}
+
+type BulkFetchChangelogsOptions struct {
+	// IssueIdsOrKeys: The issues to fetch the changelogs of, at most 1000.
+	IssueIdsOrKeys []string `json:"issueIdsOrKeys"`
+	// FieldIds: Only the changes of these fields are returned, at most 10.
+	FieldIds      []string `json:"fieldIds,omitempty"`
+	MaxResults    int      `json:"maxResults,omitempty"`
+	NextPageToken string   `json:"nextPageToken,omitempty"`
+}
+
+// IssueChangelog represents the changelog histories of an issue returned by BulkFetchChangelogs.
+type IssueChangelog struct {
+	IssueID         string              `json:"issueId"`
+	ChangeHistories []*ChangelogHistory `json:"changeHistories"`
+}
+
+// BulkChangelogs represents a page of BulkFetchChangelogs.
+type BulkChangelogs struct {
+	IssueChangeLogs []*IssueChangelog `json:"issueChangeLogs"`
+	NextPageToken   string            `json:"nextPageToken,omitempty"`
+}
+
+// BulkFetchChangelogs returns a page of the changelogs of several issues, optionally filtered by field. Cloud only.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-changelog-bulkfetch-post
+func (s *IssuesService) BulkFetchChangelogs(ctx context.Context, opts *BulkFetchChangelogsOptions) (*BulkChangelogs, error) {
+	const apiEndpoint = "/rest/api/2/changelog/bulkfetch"
+	var result BulkChangelogs
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, opts, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BulkFetchChangelogsAll walks every page of BulkFetchChangelogs by following the nextPageToken
+// and calls fn for each changelog history with the id of its issue.
+// It stops at the first error returned by fn or by Jira, or when ctx is cancelled.
+func (s *IssuesService) BulkFetchChangelogsAll(ctx context.Context, opts *BulkFetchChangelogsOptions, fn func(issueId string, history *ChangelogHistory) error) error {
+	var page BulkFetchChangelogsOptions
+	if opts != nil {
+		page = *opts
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result, err := s.BulkFetchChangelogs(ctx, &page)
+		if err != nil {
+			return err
+		}
+		for _, changelog := range result.IssueChangeLogs {
+			for _, history := range changelog.ChangeHistories {
+				if err := fn(changelog.IssueID, history); err != nil {
+					return err
+				}
+			}
+		}
+		if result.NextPageToken == "" {
+			return nil
+		}
+		page.NextPageToken = result.NextPageToken
+	}
+}
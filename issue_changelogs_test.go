@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIssuesService_BulkFetchChangelogsAll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var opts BulkFetchChangelogsOptions
+		if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+			t.Error(err)
+		}
+		if len(opts.FieldIds) != 1 || opts.FieldIds[0] != "status" {
+			t.Errorf("unexpected field ids: %v", opts.FieldIds)
+		}
+		result := BulkChangelogs{
+			IssueChangeLogs: []*IssueChangelog{{IssueID: "10000", ChangeHistories: []*ChangelogHistory{{Id: "1"}, {Id: "2"}}}},
+			NextPageToken:   "p2",
+		}
+		if opts.NextPageToken == "p2" {
+			result = BulkChangelogs{IssueChangeLogs: []*IssueChangelog{{IssueID: "10001", ChangeHistories: []*ChangelogHistory{{Id: "3"}}}}}
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	err = client.Issue.BulkFetchChangelogsAll(context.Background(), &BulkFetchChangelogsOptions{
+		IssueIdsOrKeys: []string{"TEST-1", "TEST-2"},
+		FieldIds:       []string{"status"},
+	}, func(issueId string, history *ChangelogHistory) error {
+		got = append(got, issueId+"/"+history.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[2] != "10001/3" {
+		t.Fatalf("unexpected histories: %v", got)
+	}
+}
@@ -1,8 +1,19 @@
 package jira
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
 // Comments represents a list of Comment.
 type Comments struct {
-	Comments []*Comment `json:"comments,omitempty" structs:"comments,omitempty"`
+	StartAt    int        `json:"startAt,omitempty" structs:"startAt,omitempty"`
+	MaxResults int        `json:"maxResults,omitempty" structs:"maxResults,omitempty"`
+	Total      int        `json:"total,omitempty" structs:"total,omitempty"`
+	Comments   []*Comment `json:"comments,omitempty" structs:"comments,omitempty"`
 }
 
 // CommentVisibility represents he visibility of a comment.
@@ -10,8 +21,16 @@ type Comments struct {
 type CommentVisibility struct {
 	Type  string `json:"type,omitempty" structs:"type,omitempty"`
 	Value string `json:"value,omitempty" structs:"value,omitempty"`
+	// Identifier: The ID of the group or the name of the role, Cloud only.
+	Identifier string `json:"identifier,omitempty" structs:"identifier,omitempty"`
 }
 
+// Visibility types of CommentVisibility
+const (
+	RoleVisibilityType  = "role"
+	GroupVisibilityType = "group"
+)
+
 // Comment represents a comment by a person to an issue in Jira.
 type Comment struct {
 	ID           string            `json:"id,omitempty" structs:"id,omitempty"`
@@ -19,11 +38,167 @@ type Comment struct {
 	Name         string            `json:"name,omitempty" structs:"name,omitempty"`
 	Author       User              `json:"author,omitempty" structs:"author,omitempty"`
 	Body         string            `json:"body,omitempty" structs:"body,omitempty"`
+	RenderedBody string            `json:"renderedBody,omitempty" structs:"renderedBody,omitempty"`
 	UpdateAuthor User              `json:"updateAuthor,omitempty" structs:"updateAuthor,omitempty"`
-	Updated      string            `json:"updated,omitempty" structs:"updated,omitempty"`
-	Created      string            `json:"created,omitempty" structs:"created,omitempty"`
+	Updated      *Time             `json:"updated,omitempty" structs:"updated,omitempty"`
+	Created      *Time             `json:"created,omitempty" structs:"created,omitempty"`
 	Visibility   CommentVisibility `json:"visibility,omitempty" structs:"visibility,omitempty"`
+	JsdPublic    bool              `json:"jsdPublic,omitempty" structs:"jsdPublic,omitempty"`
 
 	// A list of comment properties. Optional on create and update.
 	Properties []EntityProperty `json:"properties,omitempty" structs:"properties,omitempty"`
 }
+
+type ListCommentsOptions struct {
+	*SearchOptions `query:",inline"`
+	// OrderBy: `created` or `-created`
+	OrderBy *string `query:"orderBy,omitempty"`
+}
+
+// ListComments returns a page of the comments of an issue.
+// Use Expand `renderedBody` to return the comment body rendered in HTML.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-issue-issueidorkey-comment-get
+func (s *IssuesService) ListComments(ctx context.Context, issueIdOrKey string, opts *ListCommentsOptions) (*Comments, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/comment", issueIdOrKey)
+	var comments Comments
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, opts, &comments); err != nil {
+		return nil, err
+	}
+	return &comments, nil
+}
+
+// ListCommentsPager returns a Pager over every comment of an issue, the paging of opts.SearchOptions is replaced by pagerOpts.
+func (s *IssuesService) ListCommentsPager(issueIdOrKey string, opts *ListCommentsOptions, pagerOpts *PagerOptions) *Pager[Comment] {
+	return NewPager(func(ctx context.Context, startAt, maxResults int) (*Pagination[Comment], error) {
+		var page ListCommentsOptions
+		if opts != nil {
+			page = *opts
+		}
+		page.SearchOptions = pageSearchOptions(page.SearchOptions, startAt, maxResults)
+		comments, err := s.ListComments(ctx, issueIdOrKey, &page)
+		if err != nil {
+			return nil, err
+		}
+		return &Pagination[Comment]{
+			StartAt:    comments.StartAt,
+			MaxResults: comments.MaxResults,
+			Total:      comments.Total,
+			Values:     comments.Comments,
+		}, nil
+	}, pagerOpts)
+}
+
+type GetCommentOptions struct {
+	Expand *string `query:"expand,omitempty"`
+}
+
+// GetComment returns a comment of an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-issue-issueidorkey-comment-id-get
+func (s *IssuesService) GetComment(ctx context.Context, issueIdOrKey, id string, opts ...*GetCommentOptions) (*Comment, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/comment/%s", issueIdOrKey, id)
+	var comment Comment
+	if len(opts) > 0 && opts[0] != nil {
+		if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, opts[0], &comment); err != nil {
+			return nil, err
+		}
+		return &comment, nil
+	}
+
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+type AddCommentOptions struct {
+	// query parameters
+	Expand string `json:"-"`
+
+	Body string `json:"body"`
+	// Visibility restricts the comment to a role or a group, e.g. {Type: RoleVisibilityType, Value: "Administrators"}
+	Visibility *CommentVisibility `json:"visibility,omitempty"`
+	Properties []*EntityProperty  `json:"properties,omitempty"`
+}
+
+// AddComment adds a comment to an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-issue-issueidorkey-comment-post
+func (s *IssuesService) AddComment(ctx context.Context, issueIdOrKey string, opts *AddCommentOptions) (*Comment, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/comment", issueIdOrKey)
+	if opts != nil && opts.Expand != "" {
+		apiEndpoint = withQuery(apiEndpoint, url.Values{"expand": {opts.Expand}})
+	}
+	var comment Comment
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, opts, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+type UpdateCommentOptions struct {
+	// query parameters
+	NotifyUsers          *bool  `json:"-"`
+	OverrideEditableFlag *bool  `json:"-"`
+	Expand               string `json:"-"`
+
+	Body       string             `json:"body"`
+	Visibility *CommentVisibility `json:"visibility,omitempty"`
+	Properties []*EntityProperty  `json:"properties,omitempty"`
+}
+
+func (opts *UpdateCommentOptions) query() url.Values {
+	query := url.Values{}
+	if opts == nil {
+		return query
+	}
+	if opts.NotifyUsers != nil {
+		query.Set("notifyUsers", strconv.FormatBool(*opts.NotifyUsers))
+	}
+	if opts.OverrideEditableFlag != nil {
+		query.Set("overrideEditableFlag", strconv.FormatBool(*opts.OverrideEditableFlag))
+	}
+	if opts.Expand != "" {
+		query.Set("expand", opts.Expand)
+	}
+	return query
+}
+
+// UpdateComment updates a comment of an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-issue-issueidorkey-comment-id-put
+func (s *IssuesService) UpdateComment(ctx context.Context, issueIdOrKey, id string, opts *UpdateCommentOptions) (*Comment, error) {
+	apiEndpoint := withQuery(fmt.Sprintf("/rest/api/2/issue/%s/comment/%s", issueIdOrKey, id), opts.query())
+	var comment Comment
+	if err := s.client.Invoke(ctx, http.MethodPut, apiEndpoint, opts, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// DeleteComment deletes a comment of an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-issue-issueidorkey-comment-id-delete
+func (s *IssuesService) DeleteComment(ctx context.Context, issueIdOrKey, id string) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/comment/%s", issueIdOrKey, id)
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// GetCommentsByIDs returns a page of the comments by id, the comments the user can not see are omitted.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-comment-list-post
+func (s *IssuesService) GetCommentsByIDs(ctx context.Context, ids []int, expand ...string) (*Pagination[Comment], error) {
+	apiEndpoint := "/rest/api/2/comment/list"
+	if len(expand) > 0 && expand[0] != "" {
+		apiEndpoint = withQuery(apiEndpoint, url.Values{"expand": {expand[0]}})
+	}
+	body := struct {
+		IDs []int `json:"ids"`
+	}{IDs: ids}
+	var comments Pagination[Comment]
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, &body, &comments); err != nil {
+		return nil, err
+	}
+	return &comments, nil
+}
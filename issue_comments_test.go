@@ -0,0 +1,131 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/zdz1715/ghttp"
+	"github.com/zdz1715/go-utils/goutils"
+)
+
+func TestIssuesService_ListComments(t *testing.T) {
+	client, err := NewClient(testBasicAuthCredential, &Options{
+		ClientOpts: []ghttp.ClientOption{
+			ghttp.WithDebug(ghttp.DefaultDebug),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reply, err := client.Issue.ListComments(context.Background(), "TEST-1", &ListCommentsOptions{
+		SearchOptions: &SearchOptions{
+			StartAt:    0,
+			MaxResults: 10,
+			Expand:     "renderedBody",
+		},
+		OrderBy: goutils.Ptr("-created"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("%+v", reply)
+}
+
+func TestIssuesService_Comments(t *testing.T) {
+	type request struct {
+		method, path string
+		query        url.Values
+		body         string
+	}
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, request{method: r.Method, path: r.URL.Path, query: r.URL.Query(), body: string(body)})
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/2/issue/TEST-1/comment":
+			startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+			maxResults, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+			comments := Comments{StartAt: startAt, MaxResults: maxResults, Total: 5}
+			for i := startAt; i < startAt+maxResults && i < 5; i++ {
+				comments.Comments = append(comments.Comments, &Comment{ID: strconv.Itoa(10000 + i)})
+			}
+			_ = json.NewEncoder(w).Encode(&comments)
+		case r.Method == http.MethodPost || r.Method == http.MethodPut:
+			_, _ = w.Write([]byte(`{"id":"10000","body":"comment"}`))
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	comment, err := client.Issue.AddComment(ctx, "TEST-1", &AddCommentOptions{
+		Expand:     "renderedBody",
+		Body:       "comment",
+		Visibility: &CommentVisibility{Type: "role", Value: "Administrators"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if comment.ID != "10000" {
+		t.Errorf("got comment %+v", comment)
+	}
+	if _, err := client.Issue.UpdateComment(ctx, "TEST-1", "10000", &UpdateCommentOptions{
+		NotifyUsers: goutils.Ptr(false),
+		Body:        "edited",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Issue.DeleteComment(ctx, "TEST-1", "10000"); err != nil {
+		t.Fatal(err)
+	}
+	want := []request{
+		{http.MethodPost, "/rest/api/2/issue/TEST-1/comment", url.Values{"expand": {"renderedBody"}}, `{"body":"comment","visibility":{"type":"role","value":"Administrators"}}`},
+		{http.MethodPut, "/rest/api/2/issue/TEST-1/comment/10000", url.Values{"notifyUsers": {"false"}}, `{"body":"edited"}`},
+		{http.MethodDelete, "/rest/api/2/issue/TEST-1/comment/10000", url.Values{}, ""},
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("got requests %+v, want %+v", requests, want)
+	}
+
+	requests = nil
+	comments, err := client.Issue.ListCommentsPager("TEST-1", &ListCommentsOptions{
+		SearchOptions: &SearchOptions{Expand: "renderedBody"},
+		OrderBy:       goutils.Ptr("-created"),
+	}, &PagerOptions{PageSize: 2}).Collect(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(comments) != 5 || comments[0].ID != "10000" || comments[4].ID != "10004" {
+		t.Errorf("got comments %v", comments)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("got %d pages, want 3", len(requests))
+	}
+	for i, r := range requests {
+		query := url.Values{"startAt": {strconv.Itoa(i * 2)}, "maxResults": {"2"}, "expand": {"renderedBody"}, "orderBy": {"-created"}}
+		if i == 0 {
+			// startAt 0 is omitted
+			query.Del("startAt")
+		}
+		if !reflect.DeepEqual(r.query, query) {
+			t.Errorf("page %d: got query %v, want %v", i, r.query, query)
+		}
+	}
+}
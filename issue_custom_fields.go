@@ -0,0 +1,380 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// issueFields has the fields of IssueFields without its JSON methods.
+type issueFields IssueFields
+
+var (
+	knownIssueFieldsOnce sync.Once
+	knownIssueFields     map[string]bool
+)
+
+// isKnownIssueField reports whether key is decoded into a declared field of IssueFields.
+// encoding/json matches the keys case-insensitively, e.g. `creator` is decoded into Creator.
+func isKnownIssueField(key string) bool {
+	knownIssueFieldsOnce.Do(func() {
+		knownIssueFields = make(map[string]bool)
+		t := reflect.TypeOf(IssueFields{})
+		for i := 0; i < t.NumField(); i++ {
+			name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+			if name != "" && name != "-" {
+				knownIssueFields[strings.ToLower(name)] = true
+			}
+		}
+	})
+	return knownIssueFields[strings.ToLower(key)]
+}
+
+func (f *IssueFields) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, (*issueFields)(f)); err != nil {
+		return err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	f.Unknowns = nil
+	for key, value := range raw {
+		if isKnownIssueField(key) {
+			continue
+		}
+		if f.Unknowns == nil {
+			f.Unknowns = make(map[string]json.RawMessage)
+		}
+		f.Unknowns[key] = value
+	}
+	return nil
+}
+
+func (f IssueFields) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(issueFields(f))
+	if err != nil || len(f.Unknowns) == 0 {
+		return b, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range f.Unknowns {
+		if _, ok := fields[key]; !ok {
+			fields[key] = value
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// CustomField decodes the value of the undeclared field key into v.
+// It reports false if the field is missing or null.
+func (f *IssueFields) CustomField(key string, v interface{}) (bool, error) {
+	raw, ok := f.Unknowns[key]
+	if !ok || len(raw) == 0 || string(raw) == "null" {
+		return false, nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return false, fmt.Errorf("decode field %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// SetCustomField sets the undeclared field key to the JSON encoding of v.
+func (f *IssueFields) SetCustomField(key string, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode field %s: %w", key, err)
+	}
+	if f.Unknowns == nil {
+		f.Unknowns = make(map[string]json.RawMessage)
+	}
+	f.Unknowns[key] = raw
+	return nil
+}
+
+// FieldID returns the id of the field named name, e.g. `customfield_10016` of `Story Points`.
+// fields is the result of IssuesService.GetFields, the name is case-insensitive.
+func FieldID(fields []*Field, name string) (string, bool) {
+	for _, field := range fields {
+		if field != nil && strings.EqualFold(field.Name, name) {
+			return field.ID, true
+		}
+	}
+	return "", false
+}
+
+// CustomFieldByName is the same as CustomField but the field is looked up by display name in fields.
+func (f *IssueFields) CustomFieldByName(fields []*Field, name string, v interface{}) (bool, error) {
+	id, ok := FieldID(fields, name)
+	if !ok {
+		return false, fmt.Errorf("field %q not found", name)
+	}
+	return f.CustomField(id, v)
+}
+
+// CustomFieldOption represents an option of a select or cascading select custom field.
+type CustomFieldOption struct {
+	Self     string             `json:"self,omitempty" structs:"self,omitempty"`
+	ID       string             `json:"id,omitempty" structs:"id,omitempty"`
+	Value    string             `json:"value,omitempty" structs:"value,omitempty"`
+	Disabled bool               `json:"disabled,omitempty" structs:"disabled,omitempty"`
+	Child    *CustomFieldOption `json:"child,omitempty" structs:"child,omitempty"`
+}
+
+// CustomString returns the value of a text custom field.
+func (f *IssueFields) CustomString(key string) (string, error) {
+	var v string
+	_, err := f.CustomField(key, &v)
+	return v, err
+}
+
+// SetCustomString sets a text custom field.
+func (f *IssueFields) SetCustomString(key, value string) error {
+	return f.SetCustomField(key, value)
+}
+
+// CustomNumber returns the value of a number custom field, e.g. story points.
+func (f *IssueFields) CustomNumber(key string) (float64, error) {
+	var v float64
+	_, err := f.CustomField(key, &v)
+	return v, err
+}
+
+// SetCustomNumber sets a number custom field.
+func (f *IssueFields) SetCustomNumber(key string, value float64) error {
+	return f.SetCustomField(key, value)
+}
+
+// CustomDate returns the value of a date picker custom field, nil if not set.
+func (f *IssueFields) CustomDate(key string) (*Date, error) {
+	var v Date
+	if ok, err := f.CustomField(key, &v); !ok || err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// SetCustomDate sets a date picker custom field.
+func (f *IssueFields) SetCustomDate(key string, value Date) error {
+	return f.SetCustomField(key, value)
+}
+
+// CustomTime returns the value of a date time picker custom field, nil if not set.
+func (f *IssueFields) CustomTime(key string) (*Time, error) {
+	var v Time
+	if ok, err := f.CustomField(key, &v); !ok || err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// SetCustomTime sets a date time picker custom field.
+func (f *IssueFields) SetCustomTime(key string, value Time) error {
+	return f.SetCustomField(key, value)
+}
+
+// CustomOption returns the option of a single select or radio custom field, nil if not set.
+func (f *IssueFields) CustomOption(key string) (*CustomFieldOption, error) {
+	var v CustomFieldOption
+	if ok, err := f.CustomField(key, &v); !ok || err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// SetCustomOption sets a single select or radio custom field by option value.
+func (f *IssueFields) SetCustomOption(key, value string) error {
+	return f.SetCustomField(key, &CustomFieldOption{Value: value})
+}
+
+// CustomOptions returns the options of a multi select or checkbox custom field.
+func (f *IssueFields) CustomOptions(key string) ([]*CustomFieldOption, error) {
+	var v []*CustomFieldOption
+	_, err := f.CustomField(key, &v)
+	return v, err
+}
+
+// SetCustomOptions sets a multi select or checkbox custom field by option values.
+func (f *IssueFields) SetCustomOptions(key string, values ...string) error {
+	options := make([]*CustomFieldOption, 0, len(values))
+	for _, value := range values {
+		options = append(options, &CustomFieldOption{Value: value})
+	}
+	return f.SetCustomField(key, options)
+}
+
+// CustomCascadingOption returns the option of a cascading select custom field, the selected child is in Child.
+func (f *IssueFields) CustomCascadingOption(key string) (*CustomFieldOption, error) {
+	return f.CustomOption(key)
+}
+
+// SetCustomCascadingOption sets a cascading select custom field, child may be empty to select the parent only.
+func (f *IssueFields) SetCustomCascadingOption(key, parent, child string) error {
+	option := &CustomFieldOption{Value: parent}
+	if child != "" {
+		option.Child = &CustomFieldOption{Value: child}
+	}
+	return f.SetCustomField(key, option)
+}
+
+// userRef identifies a user by accountId on Cloud, or by name on Server and Data Center.
+type userRef struct {
+	AccountID string `json:"accountId,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+func newUserRef(user *User) *userRef {
+	if user == nil {
+		return nil
+	}
+	return &userRef{AccountID: user.AccountID, Name: user.Name}
+}
+
+// CustomUser returns the user of a user picker custom field, nil if not set.
+func (f *IssueFields) CustomUser(key string) (*User, error) {
+	var v User
+	if ok, err := f.CustomField(key, &v); !ok || err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// SetCustomUser sets a user picker custom field, the user is identified by AccountID on Cloud or by Name on Server.
+// A nil user clears the field.
+func (f *IssueFields) SetCustomUser(key string, user *User) error {
+	return f.SetCustomField(key, newUserRef(user))
+}
+
+// CustomUsers returns the users of a multi user picker custom field.
+func (f *IssueFields) CustomUsers(key string) ([]*User, error) {
+	var v []*User
+	_, err := f.CustomField(key, &v)
+	return v, err
+}
+
+// SetCustomUsers sets a multi user picker custom field.
+func (f *IssueFields) SetCustomUsers(key string, users ...*User) error {
+	refs := make([]*userRef, 0, len(users))
+	for _, user := range users {
+		if user != nil {
+			refs = append(refs, newUserRef(user))
+		}
+	}
+	return f.SetCustomField(key, refs)
+}
+
+// CustomLabels returns the value of a labels custom field.
+func (f *IssueFields) CustomLabels(key string) ([]string, error) {
+	var v []string
+	_, err := f.CustomField(key, &v)
+	return v, err
+}
+
+// SetCustomLabels sets a labels custom field.
+func (f *IssueFields) SetCustomLabels(key string, labels ...string) error {
+	if labels == nil {
+		labels = []string{}
+	}
+	return f.SetCustomField(key, labels)
+}
+
+// CustomEpicLink returns the issue key of the epic link custom field.
+func (f *IssueFields) CustomEpicLink(key string) (string, error) {
+	return f.CustomString(key)
+}
+
+// SetCustomEpicLink sets the epic link custom field to the issue key of the epic.
+func (f *IssueFields) SetCustomEpicLink(key, epicKey string) error {
+	return f.SetCustomField(key, epicKey)
+}
+
+// CustomSprints returns the sprints of the sprint custom field.
+// Jira Cloud returns sprint objects while Jira Server returns them as strings like
+// `com.atlassian.greenhopper.service.sprint.Sprint@1a2b3c[id=1,rapidViewId=2,state=ACTIVE,name=Sprint 1,...]`,
+// both are supported.
+func (f *IssueFields) CustomSprints(key string) ([]*Sprint, error) {
+	var raw []json.RawMessage
+	if ok, err := f.CustomField(key, &raw); !ok || err != nil {
+		return nil, err
+	}
+	sprints := make([]*Sprint, 0, len(raw))
+	for _, item := range raw {
+		var s string
+		if json.Unmarshal(item, &s) == nil {
+			sprint, err := parseServerSprint(s)
+			if err != nil {
+				return nil, fmt.Errorf("decode field %s: %w", key, err)
+			}
+			sprints = append(sprints, sprint)
+			continue
+		}
+		var sprint Sprint
+		if err := json.Unmarshal(item, &sprint); err != nil {
+			return nil, fmt.Errorf("decode field %s: %w", key, err)
+		}
+		sprints = append(sprints, &sprint)
+	}
+	return sprints, nil
+}
+
+// SetCustomSprint sets the sprint custom field by sprint id.
+func (f *IssueFields) SetCustomSprint(key string, sprintID int) error {
+	return f.SetCustomField(key, sprintID)
+}
+
+// parseServerSprint parses the string representation of a sprint returned by Jira Server.
+func parseServerSprint(s string) (*Sprint, error) {
+	start, end := strings.Index(s, "["), strings.LastIndex(s, "]")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("invalid sprint %q", s)
+	}
+	values := make(map[string]string)
+	key := ""
+	for _, part := range strings.Split(s[start+1:end], ",") {
+		k, v, ok := strings.Cut(part, "=")
+		if !ok && key != "" {
+			// the value contains a comma, e.g. the name or the goal
+			values[key] += "," + part
+			continue
+		}
+		key = k
+		values[key] = v
+	}
+
+	sprint := &Sprint{
+		Name:  values["name"],
+		State: values["state"],
+		Goal:  values["goal"],
+	}
+	if values["goal"] == "<null>" {
+		sprint.Goal = ""
+	}
+	var err error
+	if sprint.ID, err = strconv.Atoi(values["id"]); err != nil {
+		return nil, fmt.Errorf("invalid sprint id %q", values["id"])
+	}
+	if v, ok := values["rapidViewId"]; ok && v != "<null>" {
+		sprint.OriginBoardID, _ = strconv.Atoi(v)
+	}
+	for name, field := range map[string]**Time{
+		"startDate":    &sprint.StartDate,
+		"endDate":      &sprint.EndDate,
+		"completeDate": &sprint.CompleteDate,
+	} {
+		v, ok := values[name]
+		if !ok || v == "" || v == "<null>" {
+			continue
+		}
+		var t Time
+		if err := t.UnmarshalJSON([]byte(strconv.Quote(v))); err != nil {
+			return nil, err
+		}
+		*field = &t
+	}
+	return sprint, nil
+}
@@ -0,0 +1,77 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIssueFields_Unknowns(t *testing.T) {
+	payload := `{
+		"summary": "custom fields",
+		"creator": {"name": "admin"},
+		"customfield_10016": 5,
+		"customfield_10020": [{"id": 1, "name": "Sprint 1", "state": "active"}],
+		"customfield_10021": ["com.atlassian.greenhopper.service.sprint.Sprint@1a2b[id=2,rapidViewId=3,state=CLOSED,name=Sprint 2, hotfix,startDate=2024-03-01T09:00:00.000+08:00,endDate=<null>,completeDate=<null>,sequence=2,goal=<null>]"],
+		"customfield_10030": {"value": "Hardware", "child": {"value": "Keyboard"}},
+		"customfield_10040": null
+	}`
+	var fields IssueFields
+	if err := json.Unmarshal([]byte(payload), &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields.Summary != "custom fields" || fields.Creator == nil {
+		t.Fatalf("declared fields not decoded: %+v", fields)
+	}
+	if _, ok := fields.Unknowns["creator"]; ok {
+		t.Error("creator is a declared field")
+	}
+
+	points, err := fields.CustomNumber("customfield_10016")
+	if err != nil || points != 5 {
+		t.Errorf("story points: got %v, %v", points, err)
+	}
+	points, err = fields.CustomNumber("customfield_10040")
+	if err != nil || points != 0 {
+		t.Errorf("null field: got %v, %v", points, err)
+	}
+
+	sprints, err := fields.CustomSprints("customfield_10020")
+	if err != nil || len(sprints) != 1 || sprints[0].Name != "Sprint 1" {
+		t.Errorf("cloud sprints: got %+v, %v", sprints, err)
+	}
+	sprints, err = fields.CustomSprints("customfield_10021")
+	if err != nil || len(sprints) != 1 {
+		t.Fatalf("server sprints: got %+v, %v", sprints, err)
+	}
+	if s := sprints[0]; s.ID != 2 || s.OriginBoardID != 3 || s.Name != "Sprint 2, hotfix" || s.StartDate == nil || s.EndDate != nil {
+		t.Errorf("server sprint: got %+v", s)
+	}
+
+	option, err := fields.CustomCascadingOption("customfield_10030")
+	if err != nil || option.Value != "Hardware" || option.Child == nil || option.Child.Value != "Keyboard" {
+		t.Errorf("cascading select: got %+v, %v", option, err)
+	}
+
+	var byName float64
+	if _, err := fields.CustomFieldByName([]*Field{{ID: "customfield_10016", Name: "Story Points"}}, "story points", &byName); err != nil || byName != 5 {
+		t.Errorf("by name: got %v, %v", byName, err)
+	}
+}
+
+func TestIssueFields_MarshalJSON(t *testing.T) {
+	fields := &IssueFields{Summary: "custom fields"}
+	if err := fields.SetCustomOptions("customfield_10050", "a", "b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fields.SetCustomUser("customfield_10060", &User{AccountID: "5b10ac8d82e05b22cc7d4ef5"}); err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(&CreateIssueOptions{Fields: fields})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"fields":{"customfield_10050":[{"value":"a"},{"value":"b"}],"customfield_10060":{"accountId":"5b10ac8d82e05b22cc7d4ef5"},"summary":"custom fields"}}`
+	if string(b) != want {
+		t.Fatalf("got %s, want %s", b, want)
+	}
+}
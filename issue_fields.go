@@ -2,8 +2,8 @@ package jira
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
-	"time"
 )
 
 type Field struct {
@@ -52,6 +52,14 @@ type Watcher struct {
 	Active      bool   `json:"active,omitempty" structs:"active,omitempty"`
 }
 
+// Votes represents the votes of a Jira issue.
+type Votes struct {
+	Self     string  `json:"self,omitempty" structs:"self,omitempty"`
+	Votes    int     `json:"votes,omitempty" structs:"votes,omitempty"`
+	HasVoted bool    `json:"hasVoted,omitempty" structs:"hasVoted,omitempty"`
+	Voters   []*User `json:"voters,omitempty" structs:"voters,omitempty"`
+}
+
 // Component represents a "component" of a Jira issue.
 // Components can be user defined in every Jira instance.
 type Component struct {
@@ -94,10 +102,10 @@ type FixVersion struct {
 	Description     string `json:"description,omitempty" structs:"description,omitempty"`
 	Archived        *bool  `json:"archived,omitempty" structs:"archived,omitempty"`
 	Released        *bool  `json:"released,omitempty" structs:"released,omitempty"`
-	ReleaseDate     string `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
+	ReleaseDate     *Date  `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
 	UserReleaseDate string `json:"userReleaseDate,omitempty" structs:"userReleaseDate,omitempty"`
 	ProjectID       int    `json:"projectId,omitempty" structs:"projectId,omitempty"` // Unlike other IDs, this is returned as a number
-	StartDate       string `json:"startDate,omitempty" structs:"startDate,omitempty"`
+	StartDate       *Date  `json:"startDate,omitempty" structs:"startDate,omitempty"`
 }
 
 // Epic represents the epic to which an issue is associated
@@ -113,15 +121,15 @@ type Epic struct {
 
 // Sprint represents a sprint on Jira agile board
 type Sprint struct {
-	ID            int        `json:"id" structs:"id"`
-	Name          string     `json:"name" structs:"name"`
-	CompleteDate  *time.Time `json:"completeDate" structs:"completeDate"`
-	EndDate       *time.Time `json:"endDate" structs:"endDate"`
-	StartDate     *time.Time `json:"startDate" structs:"startDate"`
-	OriginBoardID int        `json:"originBoardId" structs:"originBoardId"`
-	Self          string     `json:"self" structs:"self"`
-	State         string     `json:"state" structs:"state"`
-	Goal          string     `json:"goal,omitempty" structs:"goal"`
+	ID            int    `json:"id" structs:"id"`
+	Name          string `json:"name" structs:"name"`
+	CompleteDate  *Time  `json:"completeDate" structs:"completeDate"`
+	EndDate       *Time  `json:"endDate" structs:"endDate"`
+	StartDate     *Time  `json:"startDate" structs:"startDate"`
+	OriginBoardID int    `json:"originBoardId" structs:"originBoardId"`
+	Self          string `json:"self" structs:"self"`
+	State         string `json:"state" structs:"state"`
+	Goal          string `json:"goal,omitempty" structs:"goal"`
 }
 
 // Parent represents the parent of a Jira issue, to be used with subtask issue types.
@@ -130,6 +138,10 @@ type Parent struct {
 	Key string `json:"key,omitempty" structs:"key,omitempty"`
 }
 
+// IssueFields represents single fields of a Jira issue.
+// Every Jira issue has several fields attached.
+// The fields not declared here, mostly custom fields like `customfield_10016`, are kept in Unknowns
+// when decoding and are sent along with the declared fields when encoding.
 type IssueFields struct {
 	Expand                        string        `json:"expand,omitempty" structs:"expand,omitempty"`
 	Issuetype                     *IssueType    `json:"issuetype,omitempty"`
@@ -137,12 +149,13 @@ type IssueFields struct {
 	Environment                   string        `json:"environment,omitempty" structs:"environment,omitempty"`
 	Resolution                    *Resolution   `json:"resolution,omitempty" structs:"resolution,omitempty"`
 	Priority                      *Priority     `json:"priority,omitempty" structs:"priority,omitempty"`
-	Resolutiondate                *time.Time    `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
-	Created                       *time.Time    `json:"created,omitempty" structs:"created,omitempty"`
-	Duedate                       *time.Time    `json:"duedate,omitempty" structs:"duedate,omitempty"`
+	Resolutiondate                *Time         `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
+	Created                       *Time         `json:"created,omitempty" structs:"created,omitempty"`
+	Duedate                       *Date         `json:"duedate,omitempty" structs:"duedate,omitempty"`
 	Watches                       *Watches      `json:"watches,omitempty" structs:"watches,omitempty"`
+	Votes                         *Votes        `json:"votes,omitempty" structs:"votes,omitempty"`
 	Assignee                      *User         `json:"assignee,omitempty" structs:"assignee,omitempty"`
-	Updated                       *time.Time    `json:"updated,omitempty" structs:"updated,omitempty"`
+	Updated                       *Time         `json:"updated,omitempty" structs:"updated,omitempty"`
 	Description                   string        `json:"description,omitempty" structs:"description,omitempty"`
 	Summary                       string        `json:"summary,omitempty" structs:"summary,omitempty"`
 	Creator                       *User         `json:"Creator,omitempty" structs:"Creator,omitempty"`
@@ -169,4 +182,7 @@ type IssueFields struct {
 	AggregateTimeOriginalEstimate int           `json:"aggregatetimeoriginalestimate,omitempty" structs:"aggregatetimeoriginalestimate,omitempty"`
 	AggregateTimeSpent            int           `json:"aggregatetimespent,omitempty" structs:"aggregatetimespent,omitempty"`
 	AggregateTimeEstimate         int           `json:"aggregatetimeestimate,omitempty" structs:"aggregatetimeestimate,omitempty"`
+
+	// Unknowns holds the raw JSON of the undeclared fields by field id, see the Custom* accessors.
+	Unknowns map[string]json.RawMessage `json:"-" structs:"-"`
 }
@@ -1,6 +1,14 @@
 package jira
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
 // IssueLink represents a link between two issues in Jira.
+// The link reads as InwardIssue Type.Outward OutwardIssue, e.g. `TEST-1 blocks TEST-2`.
 type IssueLink struct {
 	ID           string        `json:"id,omitempty" structs:"id,omitempty"`
 	Self         string        `json:"self,omitempty" structs:"self,omitempty"`
@@ -15,7 +23,140 @@ type IssueLink struct {
 type IssueLinkType struct {
 	ID      string `json:"id,omitempty" structs:"id,omitempty"`
 	Self    string `json:"self,omitempty" structs:"self,omitempty"`
-	Name    string `json:"name" structs:"name"`
-	Inward  string `json:"inward" structs:"inward"`
-	Outward string `json:"outward" structs:"outward"`
+	Name    string `json:"name,omitempty" structs:"name"`
+	Inward  string `json:"inward,omitempty" structs:"inward"`
+	Outward string `json:"outward,omitempty" structs:"outward"`
+}
+
+type CreateIssueLinkOptions struct {
+	// Type: The link type identified by ID or Name.
+	Type *IssueLinkType `json:"type"`
+	// InwardIssue and OutwardIssue are identified by ID or Key, the link reads as InwardIssue Type.Outward OutwardIssue.
+	InwardIssue  *Issue `json:"inwardIssue"`
+	OutwardIssue *Issue `json:"outwardIssue"`
+	// Comment is added to the outward issue.
+	Comment *AddCommentOptions `json:"comment,omitempty"`
+}
+
+// CreateIssueLink creates a link between two issues.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-links/#api-rest-api-2-issuelink-post
+func (s *IssuesService) CreateIssueLink(ctx context.Context, opts *CreateIssueLinkOptions) error {
+	const apiEndpoint = "/rest/api/2/issueLink"
+	return s.client.Invoke(ctx, http.MethodPost, apiEndpoint, opts, nil)
+}
+
+// GetIssueLink returns an issue link.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-links/#api-rest-api-2-issuelink-linkid-get
+func (s *IssuesService) GetIssueLink(ctx context.Context, linkId string) (*IssueLink, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issueLink/%s", linkId)
+	var link IssueLink
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// DeleteIssueLink deletes an issue link.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-links/#api-rest-api-2-issuelink-linkid-delete
+func (s *IssuesService) DeleteIssueLink(ctx context.Context, linkId string) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issueLink/%s", linkId)
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// LinkIssues links two issues by the description of the link type, e.g. LinkIssues(ctx, "TEST-1", "blocks", "TEST-2", nil)
+// or LinkIssues(ctx, "TEST-2", "is blocked by", "TEST-1", nil) for the same link.
+// The description is case-insensitive and matched against the outward description first, then the inward description.
+func (s *IssuesService) LinkIssues(ctx context.Context, issueIdOrKey, description, linkedIssueIdOrKey string, comment *AddCommentOptions) error {
+	types, err := s.ListIssueLinkTypes(ctx)
+	if err != nil {
+		return err
+	}
+	from, to := issueRef(issueIdOrKey), issueRef(linkedIssueIdOrKey)
+	for _, inward := range []bool{false, true} {
+		for _, linkType := range types {
+			opts := &CreateIssueLinkOptions{
+				Type:    &IssueLinkType{ID: linkType.ID},
+				Comment: comment,
+			}
+			switch {
+			case !inward && strings.EqualFold(linkType.Outward, description):
+				opts.InwardIssue, opts.OutwardIssue = from, to
+			case inward && strings.EqualFold(linkType.Inward, description):
+				opts.InwardIssue, opts.OutwardIssue = to, from
+			default:
+				continue
+			}
+			return s.CreateIssueLink(ctx, opts)
+		}
+	}
+	return fmt.Errorf("no issue link type with description %q", description)
+}
+
+// issueRef returns an Issue identifying the issue by id if issueIdOrKey is numeric, by key otherwise.
+func issueRef(issueIdOrKey string) *Issue {
+	if strings.Trim(issueIdOrKey, "0123456789") == "" {
+		return &Issue{ID: issueIdOrKey}
+	}
+	return &Issue{Key: issueIdOrKey}
+}
+
+// ListIssueLinkTypes returns all issue link types.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-link-types/#api-rest-api-2-issuelinktype-get
+func (s *IssuesService) ListIssueLinkTypes(ctx context.Context) ([]*IssueLinkType, error) {
+	const apiEndpoint = "/rest/api/2/issueLinkType"
+	var result struct {
+		IssueLinkTypes []*IssueLinkType `json:"issueLinkTypes"`
+	}
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.IssueLinkTypes, nil
+}
+
+// GetIssueLinkType returns an issue link type.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-link-types/#api-rest-api-2-issuelinktype-issuelinktypeid-get
+func (s *IssuesService) GetIssueLinkType(ctx context.Context, issueLinkTypeId string) (*IssueLinkType, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issueLinkType/%s", issueLinkTypeId)
+	var linkType IssueLinkType
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &linkType); err != nil {
+		return nil, err
+	}
+	return &linkType, nil
+}
+
+// CreateIssueLinkType creates an issue link type, Name, Inward and Outward are required.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-link-types/#api-rest-api-2-issuelinktype-post
+func (s *IssuesService) CreateIssueLinkType(ctx context.Context, opts *IssueLinkType) (*IssueLinkType, error) {
+	const apiEndpoint = "/rest/api/2/issueLinkType"
+	var linkType IssueLinkType
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, opts, &linkType); err != nil {
+		return nil, err
+	}
+	return &linkType, nil
+}
+
+// UpdateIssueLinkType updates an issue link type, the empty fields of opts are left unchanged.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-link-types/#api-rest-api-2-issuelinktype-issuelinktypeid-put
+func (s *IssuesService) UpdateIssueLinkType(ctx context.Context, issueLinkTypeId string, opts *IssueLinkType) (*IssueLinkType, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issueLinkType/%s", issueLinkTypeId)
+	var linkType IssueLinkType
+	if err := s.client.Invoke(ctx, http.MethodPut, apiEndpoint, opts, &linkType); err != nil {
+		return nil, err
+	}
+	return &linkType, nil
+}
+
+// DeleteIssueLinkType deletes an issue link type.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-link-types/#api-rest-api-2-issuelinktype-issuelinktypeid-delete
+func (s *IssuesService) DeleteIssueLinkType(ctx context.Context, issueLinkTypeId string) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issueLinkType/%s", issueLinkTypeId)
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, nil, nil)
 }
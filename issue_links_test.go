@@ -0,0 +1,58 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIssuesService_LinkIssues(t *testing.T) {
+	var created []CreateIssueLinkOptions
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /rest/api/2/issueLinkType":
+			_, _ = w.Write([]byte(`{"issueLinkTypes":[
+				{"id":"10000","name":"Blocks","inward":"is blocked by","outward":"blocks"},
+				{"id":"10001","name":"Relates","inward":"relates to","outward":"relates to"}
+			]}`))
+		case "POST /rest/api/2/issueLink":
+			var opts CreateIssueLinkOptions
+			if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+				t.Error(err)
+			}
+			created = append(created, opts)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if err := client.Issue.LinkIssues(ctx, "TEST-1", "Blocks", "TEST-2", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Issue.LinkIssues(ctx, "TEST-2", "is blocked by", "10001", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Issue.LinkIssues(ctx, "TEST-1", "clones", "TEST-2", nil); err == nil {
+		t.Fatal("expected an error for an unknown description")
+	}
+
+	if len(created) != 2 {
+		t.Fatalf("got %d links", len(created))
+	}
+	if l := created[0]; l.Type.ID != "10000" || l.InwardIssue.Key != "TEST-1" || l.OutwardIssue.Key != "TEST-2" {
+		t.Errorf("outward link: %+v %+v %+v", l.Type, l.InwardIssue, l.OutwardIssue)
+	}
+	if l := created[1]; l.Type.ID != "10000" || l.InwardIssue.ID != "10001" || l.OutwardIssue.Key != "TEST-2" {
+		t.Errorf("inward link: %+v %+v %+v", l.Type, l.InwardIssue, l.OutwardIssue)
+	}
+}
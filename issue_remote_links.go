@@ -0,0 +1,136 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// RemoteLink represents a link from an issue to an object of another application, e.g. a pull request or a dashboard.
+type RemoteLink struct {
+	ID   int    `json:"id,omitempty" structs:"id,omitempty"`
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+	// GlobalID identifies the remote object, creating a remote link with the GlobalID of an existing one updates it.
+	// e.g. `system=https://github.com&id=org/repo/pull/1`
+	GlobalID    string                 `json:"globalId,omitempty" structs:"globalId,omitempty"`
+	Application *RemoteLinkApplication `json:"application,omitempty" structs:"application,omitempty"`
+	// Relationship: The description of the relationship, e.g. `causes`.
+	Relationship string            `json:"relationship,omitempty" structs:"relationship,omitempty"`
+	Object       *RemoteLinkObject `json:"object,omitempty" structs:"object,omitempty"`
+}
+
+// RemoteLinkApplication represents the application of a remote link, links of the same application are grouped.
+type RemoteLinkApplication struct {
+	// Type: The name-spaced type of the application, e.g. `com.github`.
+	Type string `json:"type,omitempty" structs:"type,omitempty"`
+	// Name: The human-readable name of the application, e.g. `GitHub`.
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// RemoteLinkObject represents the remote object.
+type RemoteLinkObject struct {
+	URL     string            `json:"url" structs:"url"`
+	Title   string            `json:"title" structs:"title"`
+	Summary string            `json:"summary,omitempty" structs:"summary,omitempty"`
+	Icon    *RemoteLinkIcon   `json:"icon,omitempty" structs:"icon,omitempty"`
+	Status  *RemoteLinkStatus `json:"status,omitempty" structs:"status,omitempty"`
+}
+
+// RemoteLinkIcon represents an icon of a remote link.
+type RemoteLinkIcon struct {
+	// URL16x16: The URL of a 16x16 pixel icon.
+	URL16x16 string `json:"url16x16,omitempty" structs:"url16x16,omitempty"`
+	Title    string `json:"title,omitempty" structs:"title,omitempty"`
+	// Link: The URL opened when the icon is clicked.
+	Link string `json:"link,omitempty" structs:"link,omitempty"`
+}
+
+// RemoteLinkStatus represents the status of the remote object, a resolved object is displayed struck out.
+type RemoteLinkStatus struct {
+	Resolved bool            `json:"resolved" structs:"resolved"`
+	Icon     *RemoteLinkIcon `json:"icon,omitempty" structs:"icon,omitempty"`
+}
+
+// RemoteLinkIdentifies represents the identifiers of a created or updated remote link.
+type RemoteLinkIdentifies struct {
+	ID   int    `json:"id"`
+	Self string `json:"self"`
+}
+
+// ListRemoteLinks returns the remote links of an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-get
+func (s *IssuesService) ListRemoteLinks(ctx context.Context, issueIdOrKey string) ([]*RemoteLink, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/remotelink", issueIdOrKey)
+	var links []*RemoteLink
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &links); err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+type remoteLinkQuery struct {
+	GlobalID string `query:"globalId"`
+}
+
+// GetRemoteLinkByGlobalID returns the remote link of an issue by global id.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-get
+func (s *IssuesService) GetRemoteLinkByGlobalID(ctx context.Context, issueIdOrKey, globalID string) (*RemoteLink, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/remotelink", issueIdOrKey)
+	var link RemoteLink
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, &remoteLinkQuery{GlobalID: globalID}, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// GetRemoteLink returns a remote link of an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-linkid-get
+func (s *IssuesService) GetRemoteLink(ctx context.Context, issueIdOrKey, linkId string) (*RemoteLink, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/remotelink/%s", issueIdOrKey, linkId)
+	var link RemoteLink
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// CreateOrUpdateRemoteLink creates a remote link of an issue, or updates the remote link with the same GlobalID,
+// so a job can run again without duplicating the links.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-post
+func (s *IssuesService) CreateOrUpdateRemoteLink(ctx context.Context, issueIdOrKey string, link *RemoteLink) (*RemoteLinkIdentifies, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/remotelink", issueIdOrKey)
+	var result RemoteLinkIdentifies
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, link, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateRemoteLink updates a remote link of an issue by id.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-linkid-put
+func (s *IssuesService) UpdateRemoteLink(ctx context.Context, issueIdOrKey, linkId string, link *RemoteLink) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/remotelink/%s", issueIdOrKey, linkId)
+	return s.client.Invoke(ctx, http.MethodPut, apiEndpoint, link, nil)
+}
+
+// DeleteRemoteLink deletes a remote link of an issue by id.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-linkid-delete
+func (s *IssuesService) DeleteRemoteLink(ctx context.Context, issueIdOrKey, linkId string) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/remotelink/%s", issueIdOrKey, linkId)
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// DeleteRemoteLinkByGlobalID deletes the remote link of an issue by global id.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-delete
+func (s *IssuesService) DeleteRemoteLinkByGlobalID(ctx context.Context, issueIdOrKey, globalID string) error {
+	apiEndpoint := withQuery(fmt.Sprintf("/rest/api/2/issue/%s/remotelink", issueIdOrKey), url.Values{"globalId": {globalID}})
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
@@ -0,0 +1,138 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestIssuesService_RemoteLinkByGlobalID(t *testing.T) {
+	const (
+		globalID      = "system=https://github.com&id=org/repo/pull/1"
+		globalIDQuery = "globalId=system%3Dhttps%3A%2F%2Fgithub.com%26id%3Dorg%2Frepo%2Fpull%2F1"
+	)
+	type request struct {
+		method, path, rawQuery string
+	}
+	var (
+		requests []request
+		bodies   []*RemoteLink
+		links    = make(map[string]*RemoteLink)
+		nextID   = 10000
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, request{r.Method, r.URL.Path, r.URL.RawQuery})
+		if r.URL.Path != "/rest/api/2/issue/TEST-1/remotelink" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			var link RemoteLink
+			if err := json.NewDecoder(r.Body).Decode(&link); err != nil {
+				t.Error(err)
+			}
+			body := link
+			bodies = append(bodies, &body)
+			status := http.StatusOK
+			if existing, ok := links[link.GlobalID]; ok {
+				link.ID = existing.ID
+			} else {
+				link.ID, status = nextID, http.StatusCreated
+				nextID++
+			}
+			link.Self = "https://jira.example.com/rest/api/2/issue/TEST-1/remotelink/" + strconv.Itoa(link.ID)
+			links[link.GlobalID] = &link
+			w.WriteHeader(status)
+			_ = json.NewEncoder(w).Encode(&RemoteLinkIdentifies{ID: link.ID, Self: link.Self})
+		case http.MethodGet:
+			link, ok := links[r.URL.Query().Get("globalId")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(link)
+		case http.MethodDelete:
+			delete(links, r.URL.Query().Get("globalId"))
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	link := &RemoteLink{
+		GlobalID:     globalID,
+		Application:  &RemoteLinkApplication{Type: "com.github", Name: "GitHub"},
+		Relationship: "fixed by",
+		Object: &RemoteLinkObject{
+			URL:   "https://github.com/org/repo/pull/1",
+			Title: "org/repo#1",
+			Icon:  &RemoteLinkIcon{URL16x16: "https://github.com/favicon.ico", Title: "Pull request"},
+			Status: &RemoteLinkStatus{
+				Resolved: false,
+				Icon:     &RemoteLinkIcon{URL16x16: "https://github.com/open.png", Title: "Open", Link: "https://github.com/org/repo/pull/1"},
+			},
+		},
+	}
+	created, err := client.Issue.CreateOrUpdateRemoteLink(ctx, "TEST-1", link)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// running again with the same global id updates the link
+	updated := *link
+	updated.Object = &RemoteLinkObject{URL: link.Object.URL, Title: link.Object.Title, Status: &RemoteLinkStatus{Resolved: true}}
+	again, err := client.Issue.CreateOrUpdateRemoteLink(ctx, "TEST-1", &updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.ID != created.ID || len(links) != 1 {
+		t.Errorf("got ids %d and %d, %d links, want the link updated", created.ID, again.ID, len(links))
+	}
+	if len(bodies) != 2 || !reflect.DeepEqual(bodies[0], link) || !reflect.DeepEqual(bodies[1], &updated) {
+		t.Errorf("got bodies %+v", bodies)
+	}
+
+	got, err := client.Issue.GetRemoteLinkByGlobalID(ctx, "TEST-1", globalID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := updated
+	want.ID, want.Self = created.ID, created.Self
+	if !reflect.DeepEqual(got, &want) {
+		t.Errorf("got link %+v, want %+v", got, &want)
+	}
+	if !got.Object.Status.Resolved || got.Application.Type != "com.github" {
+		t.Errorf("got object %+v, application %+v", got.Object, got.Application)
+	}
+
+	if err := client.Issue.DeleteRemoteLinkByGlobalID(ctx, "TEST-1", globalID); err != nil {
+		t.Fatal(err)
+	}
+	if len(links) != 0 {
+		t.Errorf("got %d links after delete", len(links))
+	}
+
+	wantRequests := []request{
+		{http.MethodPost, "/rest/api/2/issue/TEST-1/remotelink", ""},
+		{http.MethodPost, "/rest/api/2/issue/TEST-1/remotelink", ""},
+		{http.MethodGet, "/rest/api/2/issue/TEST-1/remotelink", globalIDQuery},
+		{http.MethodDelete, "/rest/api/2/issue/TEST-1/remotelink", globalIDQuery},
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("got requests %+v, want %+v", requests, wantRequests)
+	}
+	if q, _ := url.ParseQuery(requests[2].rawQuery); q.Get("globalId") != globalID {
+		t.Errorf("got globalId %q", q.Get("globalId"))
+	}
+}
@@ -0,0 +1,260 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// ValidateQuery values of SearchIssuesOptions
+const (
+	ValidateQueryStrict = "strict"
+	ValidateQueryWarn   = "warn"
+	ValidateQueryNone   = "none"
+)
+
+type SearchIssuesOptions struct {
+	// JQL: The JQL that defines the search, e.g. `project = TEST ORDER BY created DESC`
+	JQL        string `query:"jql,omitempty"`
+	StartAt    int    `query:"startAt,omitempty"`
+	MaxResults int    `query:"maxResults,omitempty"`
+	// ValidateQuery: Determines how to validate the JQL query and treat the validation results, one of strict, warn, none.
+	ValidateQuery string `query:"validateQuery,omitempty"`
+	// Fields: A list of fields to return for each issue. e.g. `*all`, `*navigable`, `summary`, `-description`
+	Fields []string `query:"fields,omitempty"`
+	// Expand: A comma-separated list, e.g. `renderedFields,names,changelog`
+	Expand       string   `query:"expand,omitempty"`
+	Properties   []string `query:"properties,omitempty"`
+	FieldsByKeys bool     `query:"fieldsByKeys,omitempty"`
+}
+
+// searchIssuesRequest is the body of the POST search, where expand is a list instead of a comma-separated string.
+type searchIssuesRequest struct {
+	JQL           string   `json:"jql,omitempty"`
+	StartAt       int      `json:"startAt,omitempty"`
+	MaxResults    int      `json:"maxResults,omitempty"`
+	ValidateQuery string   `json:"validateQuery,omitempty"`
+	Fields        []string `json:"fields,omitempty"`
+	Expand        []string `json:"expand,omitempty"`
+	Properties    []string `json:"properties,omitempty"`
+	FieldsByKeys  bool     `json:"fieldsByKeys,omitempty"`
+}
+
+// SearchResult represents a page of the issues matching a JQL search.
+type SearchResult struct {
+	Expand          string                 `json:"expand,omitempty"`
+	StartAt         int                    `json:"startAt"`
+	MaxResults      int                    `json:"maxResults"`
+	Total           int                    `json:"total"`
+	Issues          []Issue                `json:"issues"`
+	WarningMessages []string               `json:"warningMessages,omitempty"`
+	Names           map[string]string      `json:"names,omitempty"`
+	Schema          map[string]FieldSchema `json:"schema,omitempty"`
+}
+
+// Search searches for issues using JQL.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-get
+func (s *IssuesService) Search(ctx context.Context, opts *SearchIssuesOptions) (*SearchResult, error) {
+	const apiEndpoint = "/rest/api/2/search"
+	var result SearchResult
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, opts, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SearchPost searches for issues using JQL, same as Search but the JQL is sent in the request body,
+// which avoids the URL length limit of long queries.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-post
+func (s *IssuesService) SearchPost(ctx context.Context, opts *SearchIssuesOptions) (*SearchResult, error) {
+	const apiEndpoint = "/rest/api/2/search"
+	var body searchIssuesRequest
+	if opts != nil {
+		body = searchIssuesRequest{
+			JQL:           opts.JQL,
+			StartAt:       opts.StartAt,
+			MaxResults:    opts.MaxResults,
+			ValidateQuery: opts.ValidateQuery,
+			Fields:        opts.Fields,
+			Properties:    opts.Properties,
+			FieldsByKeys:  opts.FieldsByKeys,
+		}
+		if opts.Expand != "" {
+			body.Expand = strings.Split(opts.Expand, ",")
+		}
+	}
+	var result SearchResult
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, &body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SearchAll walks every page of the JQL search starting at opts.StartAt and calls fn for each issue.
+// It stops at the first error returned by fn or by Jira, or when ctx is cancelled.
+func (s *IssuesService) SearchAll(ctx context.Context, opts *SearchIssuesOptions, fn func(issue *Issue) error) error {
+	var page SearchIssuesOptions
+	if opts != nil {
+		page = *opts
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result, err := s.SearchPost(ctx, &page)
+		if err != nil {
+			return err
+		}
+		for i := range result.Issues {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(&result.Issues[i]); err != nil {
+				return err
+			}
+		}
+		page.StartAt = result.StartAt + len(result.Issues)
+		if len(result.Issues) == 0 || page.StartAt >= result.Total {
+			return nil
+		}
+	}
+}
+
+type SearchJQLOptions struct {
+	// JQL: A bounded JQL query, e.g. `project = TEST ORDER BY created DESC`
+	JQL           string `query:"jql,omitempty"`
+	NextPageToken string `query:"nextPageToken,omitempty"`
+	MaxResults    int    `query:"maxResults,omitempty"`
+	// Fields: A list of fields to return for each issue. Only the issue id is returned by default.
+	Fields []string `query:"fields,omitempty"`
+	// Expand: A comma-separated list, e.g. `renderedFields,names,changelog`
+	Expand          string   `query:"expand,omitempty"`
+	Properties      []string `query:"properties,omitempty"`
+	FieldsByKeys    bool     `query:"fieldsByKeys,omitempty"`
+	FailFast        bool     `query:"failFast,omitempty"`
+	ReconcileIssues []int    `query:"reconcileIssues,omitempty"`
+}
+
+// searchJQLRequest is the body of the POST enhanced search.
+type searchJQLRequest struct {
+	JQL             string   `json:"jql,omitempty"`
+	NextPageToken   string   `json:"nextPageToken,omitempty"`
+	MaxResults      int      `json:"maxResults,omitempty"`
+	Fields          []string `json:"fields,omitempty"`
+	Expand          string   `json:"expand,omitempty"`
+	Properties      []string `json:"properties,omitempty"`
+	FieldsByKeys    bool     `json:"fieldsByKeys,omitempty"`
+	FailFast        bool     `json:"failFast,omitempty"`
+	ReconcileIssues []int    `json:"reconcileIssues,omitempty"`
+}
+
+// SearchJQLResult represents a page of the enhanced search, there is no total.
+type SearchJQLResult struct {
+	Issues        []Issue                `json:"issues"`
+	NextPageToken string                 `json:"nextPageToken,omitempty"`
+	IsLast        bool                   `json:"isLast"`
+	Names         map[string]string      `json:"names,omitempty"`
+	Schema        map[string]FieldSchema `json:"schema,omitempty"`
+}
+
+// SearchJQL searches for issues using JQL with the enhanced search of Jira Cloud, which pages by token.
+// The v2 endpoint is used because it returns the same result as v3 except for text fields
+// in Atlassian Document Format, which IssueFields can not decode.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-jql-get
+func (s *IssuesService) SearchJQL(ctx context.Context, opts *SearchJQLOptions) (*SearchJQLResult, error) {
+	const apiEndpoint = "/rest/api/2/search/jql"
+	var result SearchJQLResult
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, opts, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SearchJQLPost is the same as SearchJQL but the JQL is sent in the request body.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-jql-post
+func (s *IssuesService) SearchJQLPost(ctx context.Context, opts *SearchJQLOptions) (*SearchJQLResult, error) {
+	const apiEndpoint = "/rest/api/2/search/jql"
+	var body searchJQLRequest
+	if opts != nil {
+		body = searchJQLRequest(*opts)
+	}
+	var result SearchJQLResult
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, &body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SearchJQLAll walks every page of the enhanced search by following the nextPageToken and calls fn for each issue.
+// It stops at the first error returned by fn or by Jira, or when ctx is cancelled.
+func (s *IssuesService) SearchJQLAll(ctx context.Context, opts *SearchJQLOptions, fn func(issue *Issue) error) error {
+	var page SearchJQLOptions
+	if opts != nil {
+		page = *opts
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result, err := s.SearchJQLPost(ctx, &page)
+		if err != nil {
+			return err
+		}
+		for i := range result.Issues {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(&result.Issues[i]); err != nil {
+				return err
+			}
+		}
+		if result.IsLast || result.NextPageToken == "" {
+			return nil
+		}
+		page.NextPageToken = result.NextPageToken
+	}
+}
+
+// ApproximateCount returns an approximate number of the issues matching the bounded JQL, Cloud only.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-approximate-count-post
+func (s *IssuesService) ApproximateCount(ctx context.Context, jql string) (int, error) {
+	const apiEndpoint = "/rest/api/2/search/approximate-count"
+	var result struct {
+		Count int `json:"count"`
+	}
+	body := map[string]string{"jql": jql}
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, body, &result); err != nil {
+		return 0, err
+	}
+	return result.Count, nil
+}
+
+// SearchEach calls fn for each issue matching the JQL on any Jira deployment:
+// it uses the enhanced token search on Cloud, and the offset search on Server and Data Center.
+// StartAt and ValidateQuery are ignored on Cloud, which does not support them.
+func (s *IssuesService) SearchEach(ctx context.Context, opts *SearchIssuesOptions, fn func(issue *Issue) error) error {
+	deploymentType, err := s.client.DeploymentType(ctx)
+	if err != nil {
+		return err
+	}
+	if deploymentType != CloudDeploymentType {
+		return s.SearchAll(ctx, opts, fn)
+	}
+	var jqlOpts SearchJQLOptions
+	if opts != nil {
+		jqlOpts = SearchJQLOptions{
+			JQL:          opts.JQL,
+			MaxResults:   opts.MaxResults,
+			Fields:       opts.Fields,
+			Expand:       opts.Expand,
+			Properties:   opts.Properties,
+			FieldsByKeys: opts.FieldsByKeys,
+		}
+	}
+	return s.SearchJQLAll(ctx, &jqlOpts, fn)
+}
@@ -0,0 +1,80 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIssuesService_SearchAll(t *testing.T) {
+	const total = 5
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req searchIssuesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		result := SearchResult{StartAt: req.StartAt, MaxResults: 2, Total: total}
+		for i := req.StartAt; i < total && i < req.StartAt+2; i++ {
+			result.Issues = append(result.Issues, Issue{Key: fmt.Sprintf("TEST-%d", i+1)})
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	err = client.Issue.SearchAll(context.Background(), &SearchIssuesOptions{JQL: "project = TEST"}, func(issue *Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != total || keys[total-1] != "TEST-5" {
+		t.Fatalf("unexpected issues: %v", keys)
+	}
+}
+
+func TestIssuesService_SearchEach_Cloud(t *testing.T) {
+	pages := map[string]SearchJQLResult{
+		"":   {Issues: []Issue{{Key: "TEST-1"}, {Key: "TEST-2"}}, NextPageToken: "p2"},
+		"p2": {Issues: []Issue{{Key: "TEST-3"}}, IsLast: true},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/search/jql" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var req searchJQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		_ = json.NewEncoder(w).Encode(pages[req.NextPageToken])
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, &Options{
+		DeploymentType: CloudDeploymentType,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	err = client.Issue.SearchEach(context.Background(), &SearchIssuesOptions{JQL: "project = TEST"}, func(issue *Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 3 || keys[2] != "TEST-3" {
+		t.Fatalf("unexpected issues: %v", keys)
+	}
+}
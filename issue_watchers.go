@@ -0,0 +1,94 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetWatchers returns the watchers of an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-watchers/#api-rest-api-2-issue-issueidorkey-watchers-get
+func (s *IssuesService) GetWatchers(ctx context.Context, issueIdOrKey string) (*Watches, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/watchers", issueIdOrKey)
+	var watches Watches
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &watches); err != nil {
+		return nil, err
+	}
+	return &watches, nil
+}
+
+// AddWatcher adds a user as a watcher of an issue, the user is identified by AccountID on Cloud and by Name on Server.
+// A nil user adds the calling user.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-watchers/#api-rest-api-2-issue-issueidorkey-watchers-post
+func (s *IssuesService) AddWatcher(ctx context.Context, issueIdOrKey string, user *User) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/watchers", issueIdOrKey)
+	if user == nil {
+		return s.client.Invoke(ctx, http.MethodPost, apiEndpoint, nil, nil)
+	}
+	_, id, err := s.client.userIdentifier(ctx, user)
+	if err != nil {
+		return err
+	}
+	// the body is the JSON string of the identifier
+	return s.client.Invoke(ctx, http.MethodPost, apiEndpoint, id, nil)
+}
+
+// RemoveWatcher removes a user from the watchers of an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-watchers/#api-rest-api-2-issue-issueidorkey-watchers-delete
+func (s *IssuesService) RemoveWatcher(ctx context.Context, issueIdOrKey string, user *User) error {
+	param, id, err := s.client.userIdentifier(ctx, user)
+	if err != nil {
+		return err
+	}
+	apiEndpoint := withQuery(fmt.Sprintf("/rest/api/2/issue/%s/watchers", issueIdOrKey), url.Values{param: {id}})
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// IsWatchingIssues returns whether the calling user watches each of the issues, by issue id. Cloud only.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-watchers/#api-rest-api-2-issue-watching-post
+func (s *IssuesService) IsWatchingIssues(ctx context.Context, issueIds []string) (map[string]bool, error) {
+	const apiEndpoint = "/rest/api/2/issue/watching"
+	body := struct {
+		IssueIds []string `json:"issueIds"`
+	}{IssueIds: issueIds}
+	var result struct {
+		IssuesIsWatching map[string]bool `json:"issuesIsWatching"`
+	}
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, &body, &result); err != nil {
+		return nil, err
+	}
+	return result.IssuesIsWatching, nil
+}
+
+// GetVotes returns the votes of an issue, Voters is only returned if the user has the permission to view voters.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-votes/#api-rest-api-2-issue-issueidorkey-votes-get
+func (s *IssuesService) GetVotes(ctx context.Context, issueIdOrKey string) (*Votes, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/votes", issueIdOrKey)
+	var votes Votes
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &votes); err != nil {
+		return nil, err
+	}
+	return &votes, nil
+}
+
+// AddVote adds the vote of the calling user to an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-votes/#api-rest-api-2-issue-issueidorkey-votes-post
+func (s *IssuesService) AddVote(ctx context.Context, issueIdOrKey string) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/votes", issueIdOrKey)
+	return s.client.Invoke(ctx, http.MethodPost, apiEndpoint, nil, nil)
+}
+
+// RemoveVote removes the vote of the calling user from an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-votes/#api-rest-api-2-issue-issueidorkey-votes-delete
+func (s *IssuesService) RemoveVote(ctx context.Context, issueIdOrKey string) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/votes", issueIdOrKey)
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
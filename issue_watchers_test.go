@@ -0,0 +1,54 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIssuesService_Watcher(t *testing.T) {
+	tests := []struct {
+		deploymentType DeploymentType
+		body, query    string
+	}{
+		{deploymentType: CloudDeploymentType, body: `"5b10ac8d82e05b22cc7d4ef5"`, query: "accountId=5b10ac8d82e05b22cc7d4ef5"},
+		{deploymentType: DataCenterDeploymentType, body: `"fred"`, query: "username=fred"},
+	}
+	for _, tt := range tests {
+		var body, query string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost:
+				b, _ := io.ReadAll(r.Body)
+				body = string(b)
+			case http.MethodDelete:
+				query = r.URL.RawQuery
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, &Options{
+			DeploymentType: tt.deploymentType,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		user := &User{AccountID: "5b10ac8d82e05b22cc7d4ef5", Name: "fred"}
+		if err := client.Issue.AddWatcher(context.Background(), "TEST-1", user); err != nil {
+			t.Fatal(err)
+		}
+		if err := client.Issue.RemoveWatcher(context.Background(), "TEST-1", user); err != nil {
+			t.Fatal(err)
+		}
+		server.Close()
+
+		if body != tt.body {
+			t.Errorf("%s: got body %s, want %s", tt.deploymentType, body, tt.body)
+		}
+		if query != tt.query {
+			t.Errorf("%s: got query %s, want %s", tt.deploymentType, query, tt.query)
+		}
+	}
+}
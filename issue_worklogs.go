@@ -1,6 +1,12 @@
 package jira
 
-import "time"
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
 
 // Worklog represents the work log of a Jira issue.
 // One Worklog contains zero or n WorklogRecords
@@ -18,17 +24,328 @@ type WorklogRecord struct {
 	Author           *User            `json:"author,omitempty" structs:"author,omitempty"`
 	UpdateAuthor     *User            `json:"updateAuthor,omitempty" structs:"updateAuthor,omitempty"`
 	Comment          string           `json:"comment,omitempty" structs:"comment,omitempty"`
-	Created          *time.Time       `json:"created,omitempty" structs:"created,omitempty"`
-	Updated          *time.Time       `json:"updated,omitempty" structs:"updated,omitempty"`
-	Started          *time.Time       `json:"started,omitempty" structs:"started,omitempty"`
+	Created          *Time            `json:"created,omitempty" structs:"created,omitempty"`
+	Updated          *Time            `json:"updated,omitempty" structs:"updated,omitempty"`
+	Started          *Time            `json:"started,omitempty" structs:"started,omitempty"`
 	TimeSpent        string           `json:"timeSpent,omitempty" structs:"timeSpent,omitempty"`
 	TimeSpentSeconds int              `json:"timeSpentSeconds,omitempty" structs:"timeSpentSeconds,omitempty"`
 	ID               string           `json:"id,omitempty" structs:"id,omitempty"`
 	IssueID          string           `json:"issueId,omitempty" structs:"issueId,omitempty"`
 	Properties       []EntityProperty `json:"properties,omitempty"`
+	// Visibility restricts the worklog to a role or a group.
+	Visibility *CommentVisibility `json:"visibility,omitempty" structs:"visibility,omitempty"`
 }
 
 type EntityProperty struct {
 	Key   string      `json:"key"`
 	Value interface{} `json:"value"`
 }
+
+// AdjustEstimate values of the worklog options, defines how to update the remaining estimate of the issue.
+const (
+	// AdjustEstimateNew sets the estimate to NewEstimate.
+	AdjustEstimateNew = "new"
+	// AdjustEstimateLeave leaves the estimate unchanged.
+	AdjustEstimateLeave = "leave"
+	// AdjustEstimateManual reduces the estimate by ReduceBy, or increases it by IncreaseBy on delete.
+	AdjustEstimateManual = "manual"
+	// AdjustEstimateAuto reduces the estimate by the time spent, the default.
+	AdjustEstimateAuto = "auto"
+)
+
+type ListWorklogsOptions struct {
+	*SearchOptions `query:",inline"`
+	// StartedAfter: The worklog start date and time, as a UNIX timestamp in milliseconds, after which worklogs are returned.
+	StartedAfter *int64 `query:"startedAfter,omitempty"`
+	// StartedBefore: The worklog start date and time, as a UNIX timestamp in milliseconds, before which worklogs are returned.
+	StartedBefore *int64 `query:"startedBefore,omitempty"`
+}
+
+// ListWorklogs returns a page of the worklogs of an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-issue-issueidorkey-worklog-get
+func (s *IssuesService) ListWorklogs(ctx context.Context, issueIdOrKey string, opts *ListWorklogsOptions) (*Worklog, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/worklog", issueIdOrKey)
+	var worklog Worklog
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, opts, &worklog); err != nil {
+		return nil, err
+	}
+	return &worklog, nil
+}
+
+// ListWorklogsPager returns a Pager over every worklog of an issue, the paging of opts.SearchOptions is replaced by pagerOpts.
+func (s *IssuesService) ListWorklogsPager(issueIdOrKey string, opts *ListWorklogsOptions, pagerOpts *PagerOptions) *Pager[WorklogRecord] {
+	return NewPager(func(ctx context.Context, startAt, maxResults int) (*Pagination[WorklogRecord], error) {
+		var page ListWorklogsOptions
+		if opts != nil {
+			page = *opts
+		}
+		page.SearchOptions = pageSearchOptions(page.SearchOptions, startAt, maxResults)
+		worklog, err := s.ListWorklogs(ctx, issueIdOrKey, &page)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]*WorklogRecord, 0, len(worklog.Worklogs))
+		for i := range worklog.Worklogs {
+			values = append(values, &worklog.Worklogs[i])
+		}
+		return &Pagination[WorklogRecord]{
+			StartAt:    worklog.StartAt,
+			MaxResults: worklog.MaxResults,
+			Total:      worklog.Total,
+			Values:     values,
+		}, nil
+	}, pagerOpts)
+}
+
+type GetWorklogOptions struct {
+	// Expand: Use `properties` to return the worklog properties.
+	Expand *string `query:"expand,omitempty"`
+}
+
+// GetWorklog returns a worklog of an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-issue-issueidorkey-worklog-id-get
+func (s *IssuesService) GetWorklog(ctx context.Context, issueIdOrKey, id string, opts ...*GetWorklogOptions) (*WorklogRecord, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/worklog/%s", issueIdOrKey, id)
+	var record WorklogRecord
+	if len(opts) > 0 && opts[0] != nil {
+		if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, opts[0], &record); err != nil {
+			return nil, err
+		}
+		return &record, nil
+	}
+
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// WorklogEstimateOptions are the query parameters shared by the worklog changes.
+type WorklogEstimateOptions struct {
+	NotifyUsers *bool
+	// AdjustEstimate: One of AdjustEstimateNew, AdjustEstimateLeave, AdjustEstimateManual, AdjustEstimateAuto.
+	AdjustEstimate string
+	// NewEstimate: The value to set as the remaining estimate with AdjustEstimateNew, e.g. `2d`.
+	NewEstimate string
+	// ReduceBy: The amount to reduce the remaining estimate by with AdjustEstimateManual on add, e.g. `2d`.
+	ReduceBy string
+	// IncreaseBy: The amount to increase the remaining estimate by with AdjustEstimateManual on delete, e.g. `2d`.
+	IncreaseBy           string
+	OverrideEditableFlag *bool
+	Expand               string
+}
+
+func (opts *WorklogEstimateOptions) query() url.Values {
+	query := url.Values{}
+	if opts == nil {
+		return query
+	}
+	if opts.NotifyUsers != nil {
+		query.Set("notifyUsers", strconv.FormatBool(*opts.NotifyUsers))
+	}
+	if opts.AdjustEstimate != "" {
+		query.Set("adjustEstimate", opts.AdjustEstimate)
+	}
+	if opts.NewEstimate != "" {
+		query.Set("newEstimate", opts.NewEstimate)
+	}
+	if opts.ReduceBy != "" {
+		query.Set("reduceBy", opts.ReduceBy)
+	}
+	if opts.IncreaseBy != "" {
+		query.Set("increaseBy", opts.IncreaseBy)
+	}
+	if opts.OverrideEditableFlag != nil {
+		query.Set("overrideEditableFlag", strconv.FormatBool(*opts.OverrideEditableFlag))
+	}
+	if opts.Expand != "" {
+		query.Set("expand", opts.Expand)
+	}
+	return query
+}
+
+type AddWorklogOptions struct {
+	// query parameters
+	*WorklogEstimateOptions `json:"-"`
+
+	Comment string `json:"comment,omitempty"`
+	// Started: The datetime on which the worklog effort was started, required.
+	Started *Time `json:"started,omitempty"`
+	// TimeSpent: The time spent working on the issue as days (#d), hours (#h), or minutes (#m or #), e.g. `1d 2h`.
+	// Required when TimeSpentSeconds isn't provided.
+	TimeSpent        string             `json:"timeSpent,omitempty"`
+	TimeSpentSeconds int                `json:"timeSpentSeconds,omitempty"`
+	Visibility       *CommentVisibility `json:"visibility,omitempty"`
+	Properties       []*EntityProperty  `json:"properties,omitempty"`
+}
+
+// UpdateWorklogOptions has the same fields as AddWorklogOptions, ReduceBy is not supported by Jira.
+type UpdateWorklogOptions AddWorklogOptions
+
+// AddWorklog adds a worklog to an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-issue-issueidorkey-worklog-post
+func (s *IssuesService) AddWorklog(ctx context.Context, issueIdOrKey string, opts *AddWorklogOptions) (*WorklogRecord, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/worklog", issueIdOrKey)
+	if opts != nil {
+		apiEndpoint = withQuery(apiEndpoint, opts.WorklogEstimateOptions.query())
+	}
+	var record WorklogRecord
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, opts, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// UpdateWorklog updates a worklog of an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-issue-issueidorkey-worklog-id-put
+func (s *IssuesService) UpdateWorklog(ctx context.Context, issueIdOrKey, id string, opts *UpdateWorklogOptions) (*WorklogRecord, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/worklog/%s", issueIdOrKey, id)
+	if opts != nil {
+		apiEndpoint = withQuery(apiEndpoint, opts.WorklogEstimateOptions.query())
+	}
+	var record WorklogRecord
+	if err := s.client.Invoke(ctx, http.MethodPut, apiEndpoint, opts, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// DeleteWorklog deletes a worklog from an issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-issue-issueidorkey-worklog-id-delete
+func (s *IssuesService) DeleteWorklog(ctx context.Context, issueIdOrKey, id string, opts *WorklogEstimateOptions) error {
+	apiEndpoint := withQuery(fmt.Sprintf("/rest/api/2/issue/%s/worklog/%s", issueIdOrKey, id), opts.query())
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+// ChangedWorklog represents a worklog updated or deleted since a point in time.
+type ChangedWorklog struct {
+	WorklogID int64 `json:"worklogId"`
+	// UpdatedTime: The datetime of the change as a UNIX timestamp in milliseconds.
+	UpdatedTime int64             `json:"updatedTime"`
+	Properties  []*EntityProperty `json:"properties,omitempty"`
+}
+
+// ChangedWorklogs represents a page of the changed worklogs, the next page starts at Until.
+type ChangedWorklogs struct {
+	Values   []*ChangedWorklog `json:"values"`
+	Since    int64             `json:"since"`
+	Until    int64             `json:"until"`
+	Self     string            `json:"self,omitempty"`
+	NextPage string            `json:"nextPage,omitempty"`
+	LastPage bool              `json:"lastPage"`
+}
+
+type GetChangedWorklogsOptions struct {
+	// Since: The datetime as a UNIX timestamp in milliseconds, after which changed worklogs are returned.
+	Since int64 `query:"since,omitempty"`
+	// Expand: Use `properties` to return the worklog properties, updated worklogs only.
+	Expand *string `query:"expand,omitempty"`
+}
+
+// GetUpdatedWorklogs returns a page of the worklogs updated since opts.Since, at most 1000 per page.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-worklog-updated-get
+func (s *IssuesService) GetUpdatedWorklogs(ctx context.Context, opts *GetChangedWorklogsOptions) (*ChangedWorklogs, error) {
+	const apiEndpoint = "/rest/api/2/worklog/updated"
+	var result ChangedWorklogs
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, opts, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetDeletedWorklogs returns a page of the worklogs deleted since opts.Since, at most 1000 per page.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-worklog-deleted-get
+func (s *IssuesService) GetDeletedWorklogs(ctx context.Context, opts *GetChangedWorklogsOptions) (*ChangedWorklogs, error) {
+	const apiEndpoint = "/rest/api/2/worklog/deleted"
+	var result ChangedWorklogs
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, opts, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetWorklogsByIDs returns the worklogs by id, at most 1000 ids per request.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-worklog-list-post
+func (s *IssuesService) GetWorklogsByIDs(ctx context.Context, ids []int64, expand ...string) ([]*WorklogRecord, error) {
+	apiEndpoint := "/rest/api/2/worklog/list"
+	if len(expand) > 0 && expand[0] != "" {
+		apiEndpoint = withQuery(apiEndpoint, url.Values{"expand": {expand[0]}})
+	}
+	body := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}
+	var records []*WorklogRecord
+	if err := s.client.Invoke(ctx, http.MethodPost, apiEndpoint, &body, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// ForEachUpdatedWorklog calls fn for each worklog updated since the cursor, a UNIX timestamp in milliseconds.
+// It returns the cursor to pass on the next run, which is since if no page was read completely,
+// so a job syncing the worklogs incrementally only needs to persist the returned cursor.
+func (s *IssuesService) ForEachUpdatedWorklog(ctx context.Context, since int64, fn func(record *WorklogRecord) error) (int64, error) {
+	return forEachChangedWorklog(ctx, since, s.GetUpdatedWorklogs, func(page *ChangedWorklogs) error {
+		ids := make([]int64, 0, len(page.Values))
+		for _, value := range page.Values {
+			ids = append(ids, value.WorklogID)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		records, err := s.GetWorklogsByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			if err := fn(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ForEachDeletedWorklog calls fn for each worklog deleted since the cursor, see ForEachUpdatedWorklog.
+func (s *IssuesService) ForEachDeletedWorklog(ctx context.Context, since int64, fn func(worklog *ChangedWorklog) error) (int64, error) {
+	return forEachChangedWorklog(ctx, since, s.GetDeletedWorklogs, func(page *ChangedWorklogs) error {
+		for _, value := range page.Values {
+			if err := fn(value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func forEachChangedWorklog(ctx context.Context, since int64,
+	get func(ctx context.Context, opts *GetChangedWorklogsOptions) (*ChangedWorklogs, error),
+	handle func(page *ChangedWorklogs) error) (int64, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return since, err
+		}
+		page, err := get(ctx, &GetChangedWorklogsOptions{Since: since})
+		if err != nil {
+			return since, err
+		}
+		if err := handle(page); err != nil {
+			return since, err
+		}
+		// the cursor only moves forward after the whole page is handled
+		if page.Until > since {
+			since = page.Until
+		}
+		if page.LastPage || len(page.Values) == 0 {
+			return since, nil
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestIssuesService_ForEachUpdatedWorklog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/worklog/updated":
+			page := ChangedWorklogs{Since: 0, Until: 200, Values: []*ChangedWorklog{{WorklogID: 1}, {WorklogID: 2}}}
+			if r.URL.Query().Get("since") == "200" {
+				page = ChangedWorklogs{Since: 200, Until: 300, Values: []*ChangedWorklog{{WorklogID: 3}}, LastPage: true}
+			}
+			_ = json.NewEncoder(w).Encode(page)
+		case "/rest/api/2/worklog/list":
+			var body struct {
+				IDs []int64 `json:"ids"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			records := make([]*WorklogRecord, 0, len(body.IDs))
+			for _, id := range body.IDs {
+				records = append(records, &WorklogRecord{ID: strconv.FormatInt(id, 10)})
+			}
+			_ = json.NewEncoder(w).Encode(records)
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	until, err := client.Issue.ForEachUpdatedWorklog(context.Background(), 0, func(record *WorklogRecord) error {
+		ids = append(ids, record.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if until != 300 {
+		t.Errorf("got cursor %d, want 300", until)
+	}
+	if len(ids) != 3 || ids[2] != "3" {
+		t.Errorf("unexpected worklogs: %v", ids)
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 type IssuesService service
@@ -32,20 +35,29 @@ type Issue struct {
 	Names          map[string]string    `json:"names,omitempty" structs:"names,omitempty"`
 }
 
-// IssueFields represents single fields of a Jira issue.
-// Every Jira issue has several fields attached.
-
 // TransitionField represents the value of one Transition
+// Only Required is returned unless the transitions are requested with `expand=transitions.fields`.
 type TransitionField struct {
-	Required bool `json:"required" structs:"required"`
+	Required        bool          `json:"required" structs:"required"`
+	Name            string        `json:"name,omitempty" structs:"name,omitempty"`
+	Key             string        `json:"key,omitempty" structs:"key,omitempty"`
+	Schema          *FieldSchema  `json:"schema,omitempty" structs:"schema,omitempty"`
+	Operations      []string      `json:"operations,omitempty" structs:"operations,omitempty"`
+	AllowedValues   []interface{} `json:"allowedValues,omitempty" structs:"allowedValues,omitempty"`
+	HasDefaultValue bool          `json:"hasDefaultValue,omitempty" structs:"hasDefaultValue,omitempty"`
 }
 
 // Transition represents an issue transition in Jira
 type Transition struct {
-	ID     string                     `json:"id" structs:"id"`
-	Name   string                     `json:"name" structs:"name"`
-	To     Status                     `json:"to" structs:"status"`
-	Fields map[string]TransitionField `json:"fields" structs:"fields"`
+	ID            string                     `json:"id" structs:"id"`
+	Name          string                     `json:"name" structs:"name"`
+	To            Status                     `json:"to" structs:"status"`
+	HasScreen     bool                       `json:"hasScreen,omitempty" structs:"hasScreen,omitempty"`
+	IsGlobal      bool                       `json:"isGlobal,omitempty" structs:"isGlobal,omitempty"`
+	IsInitial     bool                       `json:"isInitial,omitempty" structs:"isInitial,omitempty"`
+	IsAvailable   bool                       `json:"isAvailable,omitempty" structs:"isAvailable,omitempty"`
+	IsConditional bool                       `json:"isConditional,omitempty" structs:"isConditional,omitempty"`
+	Fields        map[string]TransitionField `json:"fields" structs:"fields"`
 }
 
 // Wrapper struct for search result
@@ -68,13 +80,17 @@ type ChangelogItems struct {
 type ChangelogHistory struct {
 	Id      string           `json:"id" structs:"id"`
 	Author  User             `json:"author" structs:"author"`
-	Created string           `json:"created" structs:"created"`
+	Created Time             `json:"created" structs:"created"`
 	Items   []ChangelogItems `json:"items" structs:"items"`
 }
 
 // Changelog reflects the change log of an issue
+// The changelog of `expand=changelog` is truncated to the latest 100 histories, see ListChangelogs for all of them.
 type Changelog struct {
-	Histories []ChangelogHistory `json:"histories,omitempty"`
+	StartAt    int                `json:"startAt,omitempty"`
+	MaxResults int                `json:"maxResults,omitempty"`
+	Total      int                `json:"total,omitempty"`
+	Histories  []ChangelogHistory `json:"histories,omitempty"`
 }
 
 type CreateIssueOptions struct {
@@ -100,6 +116,241 @@ func (s *IssuesService) Create(ctx context.Context, opts *CreateIssueOptions) (*
 	return &issue, nil
 }
 
+type GetIssueOptions struct {
+	// Fields: A list of fields to return for the issue. e.g. `*all`, `*navigable`, `summary`, `-description`
+	Fields        []string `query:"fields,omitempty"`
+	FieldsByKeys  *bool    `query:"fieldsByKeys,omitempty"`
+	Expand        *string  `query:"expand,omitempty"`
+	Properties    []string `query:"properties,omitempty"`
+	UpdateHistory *bool    `query:"updateHistory,omitempty"`
+}
+
+// Get returns a full representation of the issue for the given issue key or id.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-get
+func (s *IssuesService) Get(ctx context.Context, issueIdOrKey string, opts ...*GetIssueOptions) (*Issue, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s", issueIdOrKey)
+	var issue Issue
+	if len(opts) > 0 && opts[0] != nil {
+		if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, opts[0], &issue); err != nil {
+			return nil, err
+		}
+		return &issue, nil
+	}
+
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// FieldOperation represents one operation of the `update` object, a single verb and its value,
+// e.g. FieldOperation{"set": nil} clears the field. The supported verbs depend on the field.
+type FieldOperation map[string]interface{}
+
+// IssueUpdate represents the `update` object of an edit request, a map of field id to the operations on it.
+// e.g. IssueUpdate{}.Add("labels", "triaged").Remove("labels", "new")
+type IssueUpdate map[string][]FieldOperation
+
+// Set adds a set operation on the field, a nil value clears the field.
+func (u IssueUpdate) Set(field string, value interface{}) IssueUpdate {
+	u[field] = append(u[field], FieldOperation{"set": value})
+	return u
+}
+
+// Add adds an add operation on the field.
+func (u IssueUpdate) Add(field string, value interface{}) IssueUpdate {
+	u[field] = append(u[field], FieldOperation{"add": value})
+	return u
+}
+
+// Remove adds a remove operation on the field.
+func (u IssueUpdate) Remove(field string, value interface{}) IssueUpdate {
+	u[field] = append(u[field], FieldOperation{"remove": value})
+	return u
+}
+
+// Edit adds an edit operation on the field.
+func (u IssueUpdate) Edit(field string, value interface{}) IssueUpdate {
+	u[field] = append(u[field], FieldOperation{"edit": value})
+	return u
+}
+
+// HistoryMetadataParticipant represents a participant of a HistoryMetadata, e.g. the actor or the generator.
+type HistoryMetadataParticipant struct {
+	ID             string `json:"id,omitempty"`
+	DisplayName    string `json:"displayName,omitempty"`
+	DisplayNameKey string `json:"displayNameKey,omitempty"`
+	Type           string `json:"type,omitempty"`
+	AvatarURL      string `json:"avatarUrl,omitempty"`
+	URL            string `json:"url,omitempty"`
+}
+
+// HistoryMetadata represents the details of an issue change recorded in the issue history.
+type HistoryMetadata struct {
+	Type                   string                      `json:"type,omitempty"`
+	Description            string                      `json:"description,omitempty"`
+	DescriptionKey         string                      `json:"descriptionKey,omitempty"`
+	ActivityDescription    string                      `json:"activityDescription,omitempty"`
+	ActivityDescriptionKey string                      `json:"activityDescriptionKey,omitempty"`
+	EmailDescription       string                      `json:"emailDescription,omitempty"`
+	EmailDescriptionKey    string                      `json:"emailDescriptionKey,omitempty"`
+	Actor                  *HistoryMetadataParticipant `json:"actor,omitempty"`
+	Generator              *HistoryMetadataParticipant `json:"generator,omitempty"`
+	Cause                  *HistoryMetadataParticipant `json:"cause,omitempty"`
+	ExtraData              map[string]string           `json:"extraData,omitempty"`
+}
+
+type UpdateIssueOptions struct {
+	// query parameters
+	NotifyUsers            *bool `json:"-"`
+	OverrideScreenSecurity *bool `json:"-"`
+	OverrideEditableFlag   *bool `json:"-"`
+	// ReturnIssue: Cloud only, the updated issue is returned when true.
+	ReturnIssue *bool `json:"-"`
+
+	Fields          *IssueFields      `json:"fields,omitempty"`
+	Update          IssueUpdate       `json:"update,omitempty"`
+	HistoryMetadata *HistoryMetadata  `json:"historyMetadata,omitempty"`
+	Properties      []*EntityProperty `json:"properties,omitempty"`
+}
+
+func (opts *UpdateIssueOptions) query() url.Values {
+	query := url.Values{}
+	if opts == nil {
+		return query
+	}
+	if opts.NotifyUsers != nil {
+		query.Set("notifyUsers", strconv.FormatBool(*opts.NotifyUsers))
+	}
+	if opts.OverrideScreenSecurity != nil {
+		query.Set("overrideScreenSecurity", strconv.FormatBool(*opts.OverrideScreenSecurity))
+	}
+	if opts.OverrideEditableFlag != nil {
+		query.Set("overrideEditableFlag", strconv.FormatBool(*opts.OverrideEditableFlag))
+	}
+	if opts.ReturnIssue != nil {
+		query.Set("returnIssue", strconv.FormatBool(*opts.ReturnIssue))
+	}
+	return query
+}
+
+// Update edits an issue, fields can be set directly by Fields or changed by the operations of Update.
+// Jira responds with no content unless ReturnIssue is true, the returned issue is nil in that case.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-put
+func (s *IssuesService) Update(ctx context.Context, issueIdOrKey string, opts *UpdateIssueOptions) (*Issue, error) {
+	apiEndpoint := withQuery(fmt.Sprintf("/rest/api/2/issue/%s", issueIdOrKey), opts.query())
+	var issue Issue
+	if err := s.client.Invoke(ctx, http.MethodPut, apiEndpoint, opts, &issue); err != nil {
+		return nil, err
+	}
+	if opts == nil || opts.ReturnIssue == nil || !*opts.ReturnIssue {
+		return nil, nil
+	}
+	return &issue, nil
+}
+
+type DeleteIssueOptions struct {
+	// DeleteSubtasks: Whether the issue's subtasks are deleted when the issue is deleted.
+	DeleteSubtasks bool
+}
+
+// Delete deletes an issue.
+// An issue cannot be deleted if it has one or more subtasks unless DeleteSubtasks is true.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-delete
+func (s *IssuesService) Delete(ctx context.Context, issueIdOrKey string, opts ...*DeleteIssueOptions) error {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s", issueIdOrKey)
+	if len(opts) > 0 && opts[0] != nil && opts[0].DeleteSubtasks {
+		apiEndpoint += "?deleteSubtasks=true"
+	}
+	return s.client.Invoke(ctx, http.MethodDelete, apiEndpoint, nil, nil)
+}
+
+type GetTransitionsOptions struct {
+	// Expand: Use `transitions.fields` to return the fields of the transition screen.
+	Expand                        *string `query:"expand,omitempty"`
+	TransitionId                  *string `query:"transitionId,omitempty"`
+	SkipRemoteOnlyCondition       *bool   `query:"skipRemoteOnlyCondition,omitempty"`
+	IncludeUnavailableTransitions *bool   `query:"includeUnavailableTransitions,omitempty"`
+	SortByOpsBarAndStatus         *bool   `query:"sortByOpsBarAndStatus,omitempty"`
+}
+
+// GetTransitions returns either all transitions or a transition that can be performed by the user on an issue, based on the issue's status.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-transitions-get
+func (s *IssuesService) GetTransitions(ctx context.Context, issueIdOrKey string, opts *GetTransitionsOptions) ([]Transition, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/transitions", issueIdOrKey)
+	var result transitionResult
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, opts, &result); err != nil {
+		return nil, err
+	}
+	return result.Transitions, nil
+}
+
+// TransitionPayload represents the transition to perform, only ID is required.
+type TransitionPayload struct {
+	ID string `json:"id"`
+}
+
+type DoTransitionOptions struct {
+	Transition TransitionPayload `json:"transition"`
+	// Comment is added to the issue together with the transition, optionally restricted by CommentVisibility.
+	Comment           string             `json:"-"`
+	CommentVisibility *CommentVisibility `json:"-"`
+
+	Fields          *IssueFields      `json:"fields,omitempty"`
+	Update          IssueUpdate       `json:"update,omitempty"`
+	HistoryMetadata *HistoryMetadata  `json:"historyMetadata,omitempty"`
+	Properties      []*EntityProperty `json:"properties,omitempty"`
+}
+
+// DoTransition performs an issue transition and, if the transition has a screen, updates the fields from the transition screen.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-transitions-post
+func (s *IssuesService) DoTransition(ctx context.Context, issueIdOrKey string, opts *DoTransitionOptions) error {
+	if opts == nil || opts.Transition.ID == "" {
+		return fmt.Errorf("transition id is required")
+	}
+	apiEndpoint := fmt.Sprintf("/rest/api/2/issue/%s/transitions", issueIdOrKey)
+	body := *opts
+	if opts.Comment != "" {
+		// comments are only accepted as an update operation, copy to keep the caller's map untouched
+		body.Update = make(IssueUpdate, len(opts.Update)+1)
+		for field, operations := range opts.Update {
+			body.Update[field] = append([]FieldOperation(nil), operations...)
+		}
+		comment := map[string]interface{}{"body": opts.Comment}
+		if opts.CommentVisibility != nil {
+			comment["visibility"] = opts.CommentVisibility
+		}
+		body.Update.Add("comment", comment)
+	}
+	return s.client.Invoke(ctx, http.MethodPost, apiEndpoint, &body, nil)
+}
+
+// TransitionToStatus performs the transition of the issue whose target status is named status,
+// so the callers do not depend on the transition ids which differ between Jira sites.
+// The status name is case-insensitive, opts.Transition is ignored.
+func (s *IssuesService) TransitionToStatus(ctx context.Context, issueIdOrKey, status string, opts *DoTransitionOptions) error {
+	transitions, err := s.GetTransitions(ctx, issueIdOrKey, nil)
+	if err != nil {
+		return err
+	}
+	var body DoTransitionOptions
+	if opts != nil {
+		body = *opts
+	}
+	for _, transition := range transitions {
+		if strings.EqualFold(transition.To.Name, status) {
+			body.Transition = TransitionPayload{ID: transition.ID}
+			return s.DoTransition(ctx, issueIdOrKey, &body)
+		}
+	}
+	return fmt.Errorf("no transition to status %q available for issue %s", status, issueIdOrKey)
+}
+
 type GetProjectIssueTypeOptions struct {
 	ProjectId *string         `query:"projectId,omitempty"`
 	Level     *IssueTypeLevel `query:"level,omitempty"`
@@ -135,3 +386,19 @@ func (s *IssuesService) GetCreateMetadataForProject(ctx context.Context, project
 	}
 	return &result, nil
 }
+
+// CreateMetadataForProjectPager returns a Pager over the create metadata issue types of the project.
+func (s *IssuesService) CreateMetadataForProjectPager(projectIdOrKey string, opts *SearchOptions, pagerOpts *PagerOptions) *Pager[IssueType] {
+	return NewPager(func(ctx context.Context, startAt, maxResults int) (*Pagination[IssueType], error) {
+		result, err := s.GetCreateMetadataForProject(ctx, projectIdOrKey, pageSearchOptions(opts, startAt, maxResults))
+		if err != nil {
+			return nil, err
+		}
+		return &Pagination[IssueType]{
+			StartAt:    int(result.StartAt),
+			MaxResults: result.MaxResults,
+			Total:      int(result.Total),
+			Values:     result.IssueTypes,
+		}, nil
+	}, pagerOpts)
+}
@@ -2,6 +2,11 @@ package jira
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/zdz1715/ghttp"
@@ -48,3 +53,157 @@ func TestIssuesService_GetCreateMetadataForProject(t *testing.T) {
 
 	t.Logf("%+v", reply)
 }
+
+func TestIssuesService_Get(t *testing.T) {
+	client, err := NewClient(testBasicAuthCredential, &Options{
+		ClientOpts: []ghttp.ClientOption{
+			ghttp.WithDebug(ghttp.DefaultDebug),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reply, err := client.Issue.Get(context.Background(), "TEST-1", &GetIssueOptions{
+		Fields: []string{"summary", "status"},
+		Expand: goutils.Ptr("names"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("%+v", reply)
+}
+
+func TestIssueUpdate_MarshalJSON(t *testing.T) {
+	opts := &UpdateIssueOptions{
+		Update: IssueUpdate{}.Add("labels", "triaged").Remove("labels", "new").Set("summary", "").Set("duedate", nil),
+	}
+	b, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"update":{"duedate":[{"set":null}],"labels":[{"add":"triaged"},{"remove":"new"}],"summary":[{"set":""}]}}`
+	if string(b) != want {
+		t.Fatalf("got %s, want %s", b, want)
+	}
+}
+
+func TestIssuesService_GetTransitions(t *testing.T) {
+	client, err := NewClient(testBasicAuthCredential, &Options{
+		ClientOpts: []ghttp.ClientOption{
+			ghttp.WithDebug(ghttp.DefaultDebug),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reply, err := client.Issue.GetTransitions(context.Background(), "TEST-1", &GetTransitionsOptions{
+		Expand: goutils.Ptr("transitions.fields"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("%+v", reply)
+}
+
+func TestIssuesService_Get_Query(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"10000","key":"TEST-1"}`))
+	}))
+	defer server.Close()
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := client.Issue.Get(ctx, "TEST-1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Issue.Get(ctx, "TEST-1", nil); err != nil {
+		t.Fatal(err)
+	}
+	issue, err := client.Issue.Get(ctx, "TEST-1", &GetIssueOptions{
+		Fields: []string{"summary", "status"},
+		Expand: goutils.Ptr("changelog"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.Key != "TEST-1" {
+		t.Errorf("got issue %+v", issue)
+	}
+	if len(queries) != 3 || len(queries[0]) != 0 || len(queries[1]) != 0 {
+		t.Fatalf("got queries %v, want no query without options", queries)
+	}
+	// Jira accepts the fields both repeated and separated by commas
+	if got := strings.Join(queries[2]["fields"], ","); got != "summary,status" {
+		t.Errorf("got fields %q, want %q", got, "summary,status")
+	}
+	if got := queries[2].Get("expand"); got != "changelog" {
+		t.Errorf("got expand %q, want %q", got, "changelog")
+	}
+}
+
+func TestIssuesService_TransitionToStatus(t *testing.T) {
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/issue/TEST-1/transitions" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"transitions":[{"id":"11","name":"Reopen","to":{"name":"To Do"}},{"id":"31","name":"Close","to":{"name":"Done"}}]}`))
+			return
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		bodies = append(bodies, body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	update := IssueUpdate{}.Add("labels", "closed")
+	if err := client.Issue.TransitionToStatus(ctx, "TEST-1", "done", &DoTransitionOptions{
+		Comment:           "Fixed in 1.2.3",
+		CommentVisibility: &CommentVisibility{Type: "role", Value: "Developers"},
+		Update:            update,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(update) != 1 || len(update["labels"]) != 1 {
+		t.Errorf("the update of the caller is changed: %v", update)
+	}
+	if len(bodies) != 1 {
+		t.Fatalf("got %d transitions, want 1", len(bodies))
+	}
+	b, _ := json.Marshal(bodies[0])
+	want := `{"transition":{"id":"31"},"update":{"comment":[{"add":{"body":"Fixed in 1.2.3","visibility":{"type":"role","value":"Developers"}}}],"labels":[{"add":"closed"}]}}`
+	if string(b) != want {
+		t.Errorf("got body %s, want %s", b, want)
+	}
+
+	err = client.Issue.TransitionToStatus(ctx, "TEST-1", "In Review", nil)
+	if err == nil || !strings.Contains(err.Error(), `no transition to status "In Review"`) {
+		t.Errorf("got %v, want an error of no transition", err)
+	}
+	if err := client.Issue.DoTransition(ctx, "TEST-1", &DoTransitionOptions{}); err == nil {
+		t.Error("want an error of no transition id")
+	}
+	if len(bodies) != 1 {
+		t.Errorf("got %d transitions, want no transition after the errors", len(bodies))
+	}
+}
@@ -3,8 +3,14 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/zdz1715/ghttp"
 )
@@ -15,18 +21,33 @@ type service struct {
 
 type Options struct {
 	ClientOpts []ghttp.ClientOption
+	// DeploymentType of the Jira instance, detected from ServerInfo on first use if empty.
+	DeploymentType DeploymentType
+	// HTTPClient sends the requests which are not JSON, e.g. the attachment upload and download.
+	// Default: http.DefaultClient
+	HTTPClient *http.Client
 }
 
 type Client struct {
 	cc   *ghttp.Client
 	opts *Options
 
+	mu             sync.Mutex
+	endpoint       string
+	deploymentType DeploymentType
+	// detectMu serializes the detection of the deployment type, without holding mu during the request
+	detectMu sync.Mutex
+
 	common service
 	// Services used for talking to different parts of the Jira API.
-	OAuth   *OAuthService
-	User    *UsersService
-	Issue   *IssuesService
-	Project *ProjectsService
+	OAuth    *OAuthService
+	User     *UsersService
+	Issue    *IssuesService
+	Project  *ProjectsService
+	Property *EntityPropertiesService
+
+	// Fields caches the field metadata of the Jira instance.
+	Fields *FieldRegistry
 }
 
 func NewClient(credential Credential, opts *Options) (*Client, error) {
@@ -47,8 +68,9 @@ func NewClient(credential Credential, opts *Options) (*Client, error) {
 	cc := ghttp.NewClient(clientOptions...)
 
 	c := &Client{
-		cc:   cc,
-		opts: opts,
+		cc:             cc,
+		opts:           opts,
+		deploymentType: opts.DeploymentType,
 	}
 
 	c.common.client = c
@@ -57,6 +79,8 @@ func NewClient(credential Credential, opts *Options) (*Client, error) {
 	c.User = (*UsersService)(&c.common)
 	c.Issue = (*IssuesService)(&c.common)
 	c.Project = (*ProjectsService)(&c.common)
+	c.Property = (*EntityPropertiesService)(&c.common)
+	c.Fields = &FieldRegistry{client: c}
 
 	if credential != nil {
 		if err := c.SetCredential(credential); err != nil {
@@ -78,6 +102,15 @@ func (c *Client) SetCredential(credential Credential) error {
 
 	c.cc.SetEndpoint(credential.GetEndpoint())
 
+	// the endpoint may point to another instance, detect it and load its fields again
+	c.mu.Lock()
+	c.endpoint = strings.TrimRight(credential.GetEndpoint(), "/")
+	c.deploymentType = c.opts.DeploymentType
+	c.mu.Unlock()
+	if c.Fields != nil {
+		c.Fields.Invalidate()
+	}
+
 	if c.OAuth != nil {
 		c.OAuth.credential = credential
 	}
@@ -91,41 +124,300 @@ func (c *Client) Invoke(ctx context.Context, method, path string, args interface
 		return err
 	}
 
+	// the query is added to the path here rather than by CallOptions.Query, so the URL is complete before
+	// the BeforeHook of the credential signs it, e.g. the signature of OAuth1 and the qsh of ConnectJWT
 	if method == http.MethodGet && args != nil {
-		callOpts.Query = args
+		query, err := encodeQuery(args)
+		if err != nil {
+			return err
+		}
+		path = withQuery(path, query)
 		args = nil
 	}
 
-	_, err = c.cc.Invoke(ctx, method, path, args, reply, callOpts)
+	resp, err := c.cc.Invoke(ctx, method, path, args, reply, callOpts)
+	if err != nil && resp != nil && (resp.StatusCode < 200 || resp.StatusCode > 299) {
+		return newError(resp, err)
+	}
 	return err
 }
 
+// resolveURL returns the URL of path, which may be relative to the endpoint or an absolute URL returned by Jira,
+// e.g. the content of an attachment. An absolute URL of the scheme and host of the endpoint is used as is,
+// an absolute URL of another host is resolved by its path against the endpoint, so the credential is never
+// sent to another host. The context path of the endpoint, e.g. `/jira`, is not repeated.
+func (c *Client) resolveURL(path string) (string, error) {
+	c.mu.Lock()
+	endpoint := c.endpoint
+	c.mu.Unlock()
+
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		return endpoint + path, nil
+	}
+	u, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	e, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	if strings.EqualFold(u.Scheme, e.Scheme) && strings.EqualFold(u.Host, e.Host) {
+		return path, nil
+	}
+	requestURI := u.RequestURI()
+	if contextPath := strings.TrimRight(e.Path, "/"); contextPath != "" &&
+		(requestURI == contextPath || strings.HasPrefix(requestURI, contextPath+"/") || strings.HasPrefix(requestURI, contextPath+"?")) {
+		requestURI = strings.TrimPrefix(requestURI, contextPath)
+	}
+	return endpoint + requestURI, nil
+}
+
+// do sends a request which is not JSON by Options.HTTPClient, with the CallOptions of the credential applied,
+// the caller must close the body of the response. A response which is not 2xx is returned as an error.
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader, header http.Header) (*http.Response, error) {
+	callOpts, err := c.OAuth.generateCallOptions()
+	if err != nil {
+		return nil, err
+	}
+	rawURL, err := c.resolveURL(path)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	if callOpts.Username != "" || callOpts.Password != "" {
+		req.SetBasicAuth(callOpts.Username, callOpts.Password)
+	}
+	if callOpts.BeforeHook != nil {
+		if err := callOpts.BeforeHook(req); err != nil {
+			return nil, err
+		}
+	}
+
+	httpClient := c.opts.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		var e Error
+		if b, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20)); len(b) > 0 {
+			_ = json.Unmarshal(b, &e)
+		}
+		return nil, newError(resp, &e)
+	}
+	return resp, nil
+}
+
+// ServerInfo represents the information about the Jira instance.
+type ServerInfo struct {
+	BaseURL        string         `json:"baseUrl"`
+	Version        string         `json:"version"`
+	VersionNumbers []int          `json:"versionNumbers"`
+	DeploymentType DeploymentType `json:"deploymentType"`
+	BuildNumber    int            `json:"buildNumber"`
+	BuildDate      string         `json:"buildDate"`
+	ServerTime     string         `json:"serverTime"`
+	ScmInfo        string         `json:"scmInfo"`
+	ServerTitle    string         `json:"serverTitle"`
+}
+
+// GetServerInfo returns information about the Jira instance.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-server-info/#api-rest-api-2-serverinfo-get
+func (c *Client) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
+	const apiEndpoint = "/rest/api/2/serverInfo"
+	var info ServerInfo
+	if err := c.Invoke(ctx, http.MethodGet, apiEndpoint, nil, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// DeploymentType returns Options.DeploymentType, or detects it from ServerInfo once.
+// Old Jira Server versions do not return a deployment type, they are treated as Server.
+func (c *Client) DeploymentType(ctx context.Context) (DeploymentType, error) {
+	c.mu.Lock()
+	deploymentType := c.deploymentType
+	c.mu.Unlock()
+	if deploymentType != "" {
+		return deploymentType, nil
+	}
+
+	c.detectMu.Lock()
+	defer c.detectMu.Unlock()
+	// detected by another call while waiting
+	c.mu.Lock()
+	deploymentType, endpoint := c.deploymentType, c.endpoint
+	c.mu.Unlock()
+	if deploymentType != "" {
+		return deploymentType, nil
+	}
+
+	info, err := c.GetServerInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	deploymentType = info.DeploymentType
+	if deploymentType == "" {
+		deploymentType = ServerDeploymentType
+	}
+	c.mu.Lock()
+	// the credential may have been changed to another instance during the request
+	if c.endpoint == endpoint {
+		c.deploymentType = deploymentType
+	}
+	c.mu.Unlock()
+	return deploymentType, nil
+}
+
+// withQuery appends the encoded query to path.
+// Non-GET requests send args as the request body, so query parameters have to be added to the path.
+func withQuery(path string, query url.Values) string {
+	if len(query) == 0 {
+		return path
+	}
+	if strings.Contains(path, "?") {
+		return path + "&" + query.Encode()
+	}
+	return path + "?" + query.Encode()
+}
+
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrPermission   = errors.New("permission denied")
+	ErrRateLimited  = errors.New("rate limited")
+)
+
+// Error represents a response of Jira which is not 2xx, check the kind of failure by errors.Is,
+// e.g. errors.Is(err, ErrNotFound), or inspect it by errors.As.
 type Error struct {
-	ErrorMessages []string    `json:"errorMessages"`
-	Errors        interface{} `json:"errors"`
-	Messages      string      `json:"message"`
+	StatusCode int         `json:"-"`
+	Method     string      `json:"-"`
+	URL        string      `json:"-"`
+	Header     http.Header `json:"-"`
+	// RequestID is the X-AREQUESTID header, which identifies the request to the Atlassian support.
+	RequestID string `json:"-"`
+
+	ErrorMessages []string `json:"errorMessages"`
+	// Errors are the errors by field, e.g. `summary`.
+	Errors   map[string]string `json:"errors"`
+	Messages string            `json:"message"`
+	// ErrorsRaw is the raw value of errors, which is not an object of the field errors for some endpoints,
+	// e.g. the errors of the elements of the bulk create.
+	ErrorsRaw json.RawMessage `json:"-"`
 }
 
+func (e *Error) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		ErrorMessages []string        `json:"errorMessages"`
+		Errors        json.RawMessage `json:"errors"`
+		Messages      string          `json:"message"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	e.ErrorMessages, e.Messages = raw.ErrorMessages, raw.Messages
+	e.Errors, e.ErrorsRaw = nil, raw.Errors
+
+	// errors is an object of the field errors, but some endpoints respond other shapes, kept in ErrorsRaw
+	var fields map[string]interface{}
+	if len(raw.Errors) == 0 || json.Unmarshal(raw.Errors, &fields) != nil {
+		return nil
+	}
+	e.Errors = make(map[string]string, len(fields))
+	for field, v := range fields {
+		if message, ok := v.(string); ok {
+			e.Errors[field] = message
+		} else if b, err := json.Marshal(v); err == nil {
+			e.Errors[field] = string(b)
+		}
+	}
+	return nil
+}
+
+// String returns the messages of the response.
 func (e *Error) String() string {
 	if e.Messages != "" {
 		return e.Messages
 	}
 
-	if len(e.ErrorMessages) > 0 {
-		return strings.Join(e.ErrorMessages, ",")
+	messages := append([]string(nil), e.ErrorMessages...)
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
 	}
-	if e.Errors != nil {
-		if b, err := json.Marshal(e.Errors); err == nil {
-			return string(b)
-		}
+	sort.Strings(fields)
+	for _, field := range fields {
+		messages = append(messages, field+": "+e.Errors[field])
+	}
+	if len(messages) == 0 && e.Errors == nil && len(e.ErrorsRaw) > 0 && string(e.ErrorsRaw) != "null" {
+		return string(e.ErrorsRaw)
+	}
+	return strings.Join(messages, ",")
+}
+
+func (e *Error) Error() string {
+	message := e.String()
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
 	}
-	return ""
+	if e.Method == "" {
+		return message
+	}
+	return fmt.Sprintf("%s %s: %d: %s", e.Method, e.URL, e.StatusCode, message)
+}
+
+// Is reports whether the status code of e is the kind of failure of target.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrPermission:
+		return e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
 }
 
 func (e *Error) Reset() {
-	e.Errors = nil
-	e.Messages = ""
-	e.ErrorMessages = nil
+	*e = Error{}
+}
+
+// newError returns the *Error of err, or an *Error of the message of err if there is none,
+// with the request and response of resp.
+func newError(resp *http.Response, err error) *Error {
+	var e *Error
+	if !errors.As(err, &e) {
+		e = &Error{}
+		if err != nil {
+			e.Messages = err.Error()
+		}
+	}
+	e.StatusCode = resp.StatusCode
+	e.Header = resp.Header
+	e.RequestID = resp.Header.Get("X-AREQUESTID")
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			e.URL = resp.Request.URL.String()
+		}
+	}
+	return e
 }
 
 // SearchOptions specifies the optional parameters to various List methods that
@@ -0,0 +1,256 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		kind   error
+		want   Error
+		raw    string
+	}{
+		{
+			status: http.StatusNotFound,
+			body:   `{"errorMessages":["Issue does not exist or you do not have permission to see it."],"errors":{}}`,
+			kind:   ErrNotFound,
+			want:   Error{ErrorMessages: []string{"Issue does not exist or you do not have permission to see it."}},
+		},
+		{
+			status: http.StatusBadRequest,
+			body:   `{"errorMessages":[],"errors":{"summary":"You must specify a summary of the issue.","customfield_10016":{"message":"invalid"}}}`,
+			want: Error{Errors: map[string]string{
+				"summary":           "You must specify a summary of the issue.",
+				"customfield_10016": `{"message":"invalid"}`,
+			}},
+		},
+		{status: http.StatusUnauthorized, body: `{"message":"Client must be authenticated"}`, kind: ErrUnauthorized, want: Error{Messages: "Client must be authenticated"}},
+		{status: http.StatusForbidden, body: `{"errorMessages":["forbidden"]}`, kind: ErrPermission, want: Error{ErrorMessages: []string{"forbidden"}}},
+		{status: http.StatusTooManyRequests, body: `{"errors":[{"status":429}]}`, kind: ErrRateLimited, raw: `[{"status":429}]`},
+	}
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-AREQUESTID", "request-1")
+			w.WriteHeader(tt.status)
+			_, _ = w.Write([]byte(tt.body))
+		}))
+		client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = client.Issue.Get(context.Background(), "TEST-1")
+		server.Close()
+
+		var e *Error
+		if !errors.As(err, &e) {
+			t.Fatalf("%d: got %T %v, want *Error", tt.status, err, err)
+		}
+		if e.StatusCode != tt.status || e.Method != http.MethodGet || !strings.HasSuffix(e.URL, "/rest/api/2/issue/TEST-1") ||
+			e.RequestID != "request-1" || e.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("%d: got %+v", tt.status, e)
+		}
+		if e.Messages != tt.want.Messages || strings.Join(e.ErrorMessages, ",") != strings.Join(tt.want.ErrorMessages, ",") || len(e.Errors) != len(tt.want.Errors) {
+			t.Errorf("%d: got %+v, want %+v", tt.status, e, tt.want)
+		}
+		if tt.raw != "" && (string(e.ErrorsRaw) != tt.raw || !strings.HasSuffix(e.Error(), ": "+tt.raw)) {
+			t.Errorf("%d: got ErrorsRaw %s, error %q, want %s", tt.status, e.ErrorsRaw, e.Error(), tt.raw)
+		}
+		for field, message := range tt.want.Errors {
+			if e.Errors[field] != message {
+				t.Errorf("%d: got error of %s %q, want %q", tt.status, field, e.Errors[field], message)
+			}
+		}
+		for _, kind := range []error{ErrNotFound, ErrUnauthorized, ErrPermission, ErrRateLimited} {
+			if got := errors.Is(err, kind); got != (kind == tt.kind) {
+				t.Errorf("%d: errors.Is(err, %v) = %v", tt.status, kind, got)
+			}
+		}
+	}
+}
+
+func TestError_Do(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errorMessages":["The attachment does not exist"]}`))
+	}))
+	defer server.Close()
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Issue.DownloadAttachment(context.Background(), &Attachment{Content: server.URL + "/secure/attachment/1/a.txt"}, io.Discard)
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("got %v, want ErrNotFound", err)
+	}
+	want := "GET " + server.URL + "/secure/attachment/1/a.txt: 404: The attachment does not exist"
+	if err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+}
+
+func TestError_Reset(t *testing.T) {
+	e := &Error{StatusCode: http.StatusBadRequest, Errors: map[string]string{"summary": "required"}}
+	e.Reset()
+	if b, _ := json.Marshal(e); string(b) != `{"errorMessages":null,"errors":null,"message":""}` {
+		t.Errorf("got %s", b)
+	}
+	if e.StatusCode != 0 {
+		t.Errorf("got status %d", e.StatusCode)
+	}
+}
+
+func TestClient_resolveURL(t *testing.T) {
+	client, err := NewClient(&BasicAuth{Endpoint: "https://jira.example.com/jira/", Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path, want string
+	}{
+		{"/rest/api/2/attachment/1", "https://jira.example.com/jira/rest/api/2/attachment/1"},
+		{"https://jira.example.com/jira/secure/attachment/1/a.txt", "https://jira.example.com/jira/secure/attachment/1/a.txt"},
+		{"https://JIRA.example.com/jira/secure/attachment/1/a.txt", "https://JIRA.example.com/jira/secure/attachment/1/a.txt"},
+		{"https://jira.internal/jira/secure/attachment/1/a.txt?x=1", "https://jira.example.com/jira/secure/attachment/1/a.txt?x=1"},
+		{"https://jira.internal/jiraa/secure/attachment/1/a.txt", "https://jira.example.com/jira/jiraa/secure/attachment/1/a.txt"},
+		{"http://jira.example.com/jira/secure/attachment/1/a.txt", "https://jira.example.com/jira/secure/attachment/1/a.txt"},
+		{"https://example.atlassian.net/rest/api/2/attachment/content/1", "https://jira.example.com/jira/rest/api/2/attachment/content/1"},
+	}
+	for _, tt := range tests {
+		got, err := client.resolveURL(tt.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("resolveURL(%s) = %s, want %s", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestClient_do_ContextPath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/jira/secure/attachment/1/a.txt" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("content"))
+	}))
+	defer server.Close()
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL + "/jira", Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the base URL of Jira differs from the endpoint, e.g. behind a proxy
+	for _, content := range []string{server.URL + "/jira/secure/attachment/1/a.txt", "https://jira.internal/jira/secure/attachment/1/a.txt"} {
+		var b strings.Builder
+		if _, err := client.Issue.DownloadAttachment(context.Background(), &Attachment{Content: content}, &b); err != nil {
+			t.Fatalf("%s: %v", content, err)
+		}
+		if b.String() != "content" {
+			t.Errorf("%s: got %q", content, b.String())
+		}
+	}
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_do_HTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("content"))
+	}))
+	defer server.Close()
+	var sent int
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		sent++
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+	client, err := NewClient(&BearerToken{Endpoint: server.URL, Token: "token"}, &Options{HTTPClient: httpClient})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if _, err := client.Issue.DownloadAttachment(context.Background(), &Attachment{Content: server.URL + "/secure/attachment/1/a.txt"}, &b); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != "content" || sent != 1 {
+		t.Errorf("got %q by %d requests of Options.HTTPClient", b.String(), sent)
+	}
+}
+
+func TestClient_DeploymentType(t *testing.T) {
+	var requests int32
+	arrived, release := make(chan struct{}), make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			close(arrived)
+		}
+		<-release
+		_, _ = w.Write([]byte(`{"deploymentType":"Cloud"}`))
+	}))
+	defer server.Close()
+	client, err := NewClient(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	results := make([]DeploymentType, 2)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			deploymentType, err := client.DeploymentType(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+			results[i] = deploymentType
+		}(i)
+	}
+
+	// the client is not locked during the detection
+	<-arrived
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := client.resolveURL("/rest/api/2/attachment/1"); err != nil {
+			t.Error(err)
+		}
+		if err := client.SetCredential(&BasicAuth{Endpoint: server.URL, Username: "u", Password: "p"}); err != nil {
+			t.Error(err)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("resolveURL and SetCredential blocked by the detection of the deployment type")
+	}
+	close(release)
+	wg.Wait()
+
+	if results[0] != CloudDeploymentType || results[1] != CloudDeploymentType {
+		t.Errorf("got %v, want Cloud", results)
+	}
+	if requests != 1 {
+		t.Errorf("got %d requests of the server info, want 1", requests)
+	}
+}
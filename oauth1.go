@@ -0,0 +1,240 @@
+package jira
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zdz1715/ghttp"
+)
+
+// OAuth1Config is the consumer of an application link of Jira Server, signing by RSA-SHA1.
+// Jira docs: https://developer.atlassian.com/server/jira/platform/oauth/
+type OAuth1Config struct {
+	// Endpoint of Jira, e.g. https://jira.example.com
+	Endpoint    string
+	ConsumerKey string
+	// PrivateKey of the consumer, the public key is configured in the application link, see ParseRSAPrivateKey.
+	PrivateKey *rsa.PrivateKey
+	// CallbackURL receives the verifier after the user authorized the request token. Default: `oob`,
+	// the verifier is shown to the user.
+	CallbackURL string
+
+	// HTTPClient sends the token requests. Default: http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// OAuth1Token represents a request token or an access token.
+type OAuth1Token struct {
+	Token  string `json:"token" xml:"token"`
+	Secret string `json:"secret,omitempty" xml:"secret,omitempty"`
+}
+
+// ParseRSAPrivateKey parses a PEM encoded PKCS #1 or PKCS #8 RSA private key.
+func ParseRSAPrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("oauth1: no PEM block of the private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("oauth1: parse private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("oauth1: %T is not an RSA private key", key)
+	}
+	return rsaKey, nil
+}
+
+func (c *OAuth1Config) url(path string) string {
+	return strings.TrimRight(c.Endpoint, "/") + path
+}
+
+// RequestToken obtains a request token, which the user authorizes at AuthorizeURL.
+func (c *OAuth1Config) RequestToken(ctx context.Context) (*OAuth1Token, error) {
+	callback := c.CallbackURL
+	if callback == "" {
+		callback = "oob"
+	}
+	return c.token(ctx, "/plugins/servlet/oauth/request-token", map[string]string{"oauth_callback": callback})
+}
+
+// AuthorizeURL returns the URL at which the user authorizes the request token.
+func (c *OAuth1Config) AuthorizeURL(requestToken *OAuth1Token) string {
+	return withQuery(c.url("/plugins/servlet/oauth/authorize"), url.Values{"oauth_token": {requestToken.Token}})
+}
+
+// AccessToken exchanges the authorized request token and the verifier for an access token.
+func (c *OAuth1Config) AccessToken(ctx context.Context, requestToken *OAuth1Token, verifier string) (*OAuth1Token, error) {
+	return c.token(ctx, "/plugins/servlet/oauth/access-token", map[string]string{
+		"oauth_token":    requestToken.Token,
+		"oauth_verifier": verifier,
+	})
+}
+
+func (c *OAuth1Config) token(ctx context.Context, path string, params map[string]string) (*OAuth1Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url(path), nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.sign(req, params); err != nil {
+		return nil, err
+	}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	values, _ := url.ParseQuery(string(data))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if problem := values.Get("oauth_problem"); problem != "" {
+			return nil, fmt.Errorf("oauth1: %s: %s", resp.Status, problem)
+		}
+		return nil, fmt.Errorf("oauth1: %s", resp.Status)
+	}
+	token := &OAuth1Token{Token: values.Get("oauth_token"), Secret: values.Get("oauth_token_secret")}
+	if token.Token == "" {
+		return nil, errors.New("oauth1: no oauth_token in the response")
+	}
+	return token, nil
+}
+
+// sign sets the Authorization header of req, signed by RSA-SHA1 with the oauth parameters, params and the query of req.
+// A form body is not signed, Jira is only sent JSON and multipart bodies.
+func (c *OAuth1Config) sign(req *http.Request, params map[string]string) error {
+	if c.PrivateKey == nil {
+		return errors.New("oauth1: nil private key")
+	}
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	oauthParams := map[string]string{
+		"oauth_consumer_key":     c.ConsumerKey,
+		"oauth_nonce":            hex.EncodeToString(nonce),
+		"oauth_signature_method": "RSA-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(time.Now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	for key, value := range params {
+		oauthParams[key] = value
+	}
+
+	hashed := sha1.Sum([]byte(oauth1BaseString(req, oauthParams)))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.PrivateKey, crypto.SHA1, hashed[:])
+	if err != nil {
+		return fmt.Errorf("oauth1: sign: %w", err)
+	}
+	oauthParams["oauth_signature"] = base64.StdEncoding.EncodeToString(signature)
+
+	keys := make([]string, 0, len(oauthParams))
+	for key := range oauthParams {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, percentEncode(key), percentEncode(oauthParams[key])))
+	}
+	req.Header.Set("Authorization", "OAuth "+strings.Join(pairs, ", "))
+	return nil
+}
+
+// oauth1BaseString returns the signature base string of req, see RFC 5849 section 3.4.1.
+func oauth1BaseString(req *http.Request, oauthParams map[string]string) string {
+	pairs := make([]string, 0, len(oauthParams))
+	for key, value := range oauthParams {
+		pairs = append(pairs, percentEncode(key)+"="+percentEncode(value))
+	}
+	for key, values := range req.URL.Query() {
+		for _, value := range values {
+			pairs = append(pairs, percentEncode(key)+"="+percentEncode(value))
+		}
+	}
+	sort.Strings(pairs)
+
+	host := strings.ToLower(req.URL.Host)
+	scheme := strings.ToLower(req.URL.Scheme)
+	if port := req.URL.Port(); (scheme == "http" && port == "80") || (scheme == "https" && port == "443") {
+		host = strings.ToLower(req.URL.Hostname())
+	}
+	baseURL := scheme + "://" + host + req.URL.EscapedPath()
+
+	return strings.Join([]string{
+		percentEncode(strings.ToUpper(req.Method)),
+		percentEncode(baseURL),
+		percentEncode(strings.Join(pairs, "&")),
+	}, "&")
+}
+
+// percentEncode percent-encodes s except the unreserved characters of RFC 3986, as required by
+// the OAuth 1.0a signature and the query string hash of Atlassian Connect.
+func percentEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// OAuth1 is the Credential of an access token of OAuth1Config, every request is signed with its query parameters.
+type OAuth1 struct {
+	Config      *OAuth1Config
+	AccessToken *OAuth1Token
+}
+
+func (o *OAuth1) GetEndpoint() string {
+	if o.Config == nil {
+		return ""
+	}
+	return o.Config.Endpoint
+}
+
+func (o *OAuth1) GenerateCallOptions() (*ghttp.CallOptions, error) {
+	return &ghttp.CallOptions{
+		// the request is signed when it is complete, the query is added by ghttp
+		BeforeHook: func(req *http.Request) error {
+			return o.Config.sign(req, map[string]string{"oauth_token": o.AccessToken.Token})
+		},
+	}, nil
+}
+
+func (o *OAuth1) Valid() error {
+	if o.Config == nil || o.Config.Endpoint == "" || o.Config.ConsumerKey == "" || o.Config.PrivateKey == nil ||
+		o.AccessToken == nil || o.AccessToken.Token == "" {
+		return ErrCredential
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package jira
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// verifyOAuth1 verifies the RSA-SHA1 signature of r and returns its oauth parameters.
+func verifyOAuth1(t *testing.T, r *http.Request, key *rsa.PublicKey) map[string]string {
+	escape := func(s string) string {
+		return strings.ReplaceAll(strings.ReplaceAll(url.QueryEscape(s), "+", "%20"), "%7E", "~")
+	}
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "OAuth ") {
+		t.Fatalf("got Authorization %q", authorization)
+	}
+	params := make(map[string]string)
+	for _, pair := range strings.Split(strings.TrimPrefix(authorization, "OAuth "), ", ") {
+		key, value, _ := strings.Cut(pair, "=")
+		params[key], _ = url.PathUnescape(strings.Trim(value, `"`))
+	}
+
+	var pairs []string
+	for key, value := range params {
+		if key != "oauth_signature" {
+			pairs = append(pairs, escape(key)+"="+escape(value))
+		}
+	}
+	for key, values := range r.URL.Query() {
+		for _, value := range values {
+			pairs = append(pairs, escape(key)+"="+escape(value))
+		}
+	}
+	sort.Strings(pairs)
+	base := r.Method + "&" + escape("http://"+r.Host+r.URL.EscapedPath()) + "&" + escape(strings.Join(pairs, "&"))
+
+	signature, err := base64.StdEncoding.DecodeString(params["oauth_signature"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashed := sha1.Sum([]byte(base))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA1, hashed[:], signature); err != nil {
+		t.Errorf("%s %s: invalid signature of %s", r.Method, r.URL, base)
+	}
+	if params["oauth_consumer_key"] != "consumer" || params["oauth_signature_method"] != "RSA-SHA1" {
+		t.Errorf("got oauth parameters %v", params)
+	}
+	return params
+}
+
+func TestOAuth1(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var query url.Values
+	mux := http.NewServeMux()
+	mux.HandleFunc("/plugins/servlet/oauth/request-token", func(w http.ResponseWriter, r *http.Request) {
+		if params := verifyOAuth1(t, r, &privateKey.PublicKey); params["oauth_callback"] != "oob" {
+			t.Errorf("got oauth_callback %q", params["oauth_callback"])
+		}
+		_, _ = w.Write([]byte("oauth_token=request&oauth_token_secret=secret"))
+	})
+	mux.HandleFunc("/plugins/servlet/oauth/access-token", func(w http.ResponseWriter, r *http.Request) {
+		if params := verifyOAuth1(t, r, &privateKey.PublicKey); params["oauth_token"] != "request" || params["oauth_verifier"] != "verifier" {
+			t.Errorf("got oauth parameters %v", params)
+		}
+		_, _ = w.Write([]byte("oauth_token=access&oauth_token_secret=secret"))
+	})
+	mux.HandleFunc("/rest/api/2/project/search", func(w http.ResponseWriter, r *http.Request) {
+		if params := verifyOAuth1(t, r, &privateKey.PublicKey); params["oauth_token"] != "access" {
+			t.Errorf("got oauth_token %q", params["oauth_token"])
+		}
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"values":[]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	parsed, err := ParseRSAPrivateKey(keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &OAuth1Config{Endpoint: server.URL, ConsumerKey: "consumer", PrivateKey: parsed}
+
+	ctx := context.Background()
+	requestToken, err := config.RequestToken(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := config.AuthorizeURL(requestToken); got != server.URL+"/plugins/servlet/oauth/authorize?oauth_token=request" {
+		t.Errorf("got authorize URL %s", got)
+	}
+	accessToken, err := config.AccessToken(ctx, requestToken, "verifier")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewClient(&OAuth1{Config: config, AccessToken: accessToken}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Project.ListProjects(ctx, &ListProjectOptions{
+		SearchOptions: &SearchOptions{MaxResults: 10, Expand: "issueTypes,lead"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if query.Get("expand") != "issueTypes,lead" {
+		t.Errorf("got query %v", query)
+	}
+}
@@ -0,0 +1,325 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zdz1715/ghttp"
+)
+
+// The endpoints of the OAuth 2.0 authorization code grant (3LO) of Atlassian Cloud.
+const (
+	AtlassianAuthURL      = "https://auth.atlassian.com/authorize"
+	AtlassianTokenURL     = "https://auth.atlassian.com/oauth/token"
+	AtlassianResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+	AtlassianAPIURL       = "https://api.atlassian.com/ex/jira/"
+)
+
+// DefaultOAuth2ExpiryDelta is how long before its expiry the access token is refreshed.
+const DefaultOAuth2ExpiryDelta = time.Minute
+
+var ErrTokenNotFound = errors.New("oauth2 token not found")
+
+// OAuth2Config is the OAuth 2.0 (3LO) app of Atlassian Cloud.
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/
+type OAuth2Config struct {
+	ClientID     string `json:"clientId" xml:"clientId"`
+	ClientSecret string `json:"clientSecret" xml:"clientSecret"`
+	RedirectURL  string `json:"redirectUrl" xml:"redirectUrl"`
+	// Scopes e.g. `read:jira-work`, `write:jira-work`, `offline_access` is required for the refresh token.
+	Scopes []string `json:"scopes" xml:"scopes"`
+
+	// AuthURL, TokenURL, ResourcesURL and APIURL default to the endpoints of Atlassian Cloud.
+	AuthURL      string `json:"authUrl,omitempty" xml:"authUrl,omitempty"`
+	TokenURL     string `json:"tokenUrl,omitempty" xml:"tokenUrl,omitempty"`
+	ResourcesURL string `json:"resourcesUrl,omitempty" xml:"resourcesUrl,omitempty"`
+	APIURL       string `json:"apiUrl,omitempty" xml:"apiUrl,omitempty"`
+
+	// HTTPClient sends the token and resources requests. Default: http.DefaultClient
+	HTTPClient *http.Client `json:"-" xml:"-"`
+}
+
+func (c *OAuth2Config) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func orDefault(s, def string) string {
+	if s != "" {
+		return s
+	}
+	return def
+}
+
+// AuthCodeURL returns the URL to which the user is redirected to grant access to the app,
+// state is returned to RedirectURL and must be checked to prevent CSRF.
+func (c *OAuth2Config) AuthCodeURL(state string) string {
+	query := url.Values{}
+	query.Set("audience", "api.atlassian.com")
+	query.Set("client_id", c.ClientID)
+	query.Set("scope", strings.Join(c.Scopes, " "))
+	query.Set("redirect_uri", c.RedirectURL)
+	query.Set("state", state)
+	query.Set("response_type", "code")
+	query.Set("prompt", "consent")
+	return withQuery(orDefault(c.AuthURL, AtlassianAuthURL), query)
+}
+
+// OAuth2Token represents the tokens of a grant.
+type OAuth2Token struct {
+	AccessToken string `json:"access_token"`
+	// RefreshToken is rotated, every refresh returns a new one and invalidates the old one.
+	RefreshToken string `json:"refresh_token,omitempty"`
+	TokenType    string `json:"token_type,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	// Expiry of the access token, zero if it does not expire.
+	Expiry time.Time `json:"expiry"`
+}
+
+// expired reports whether the access token expires within delta.
+func (t *OAuth2Token) expired(delta time.Duration) bool {
+	return !t.Expiry.IsZero() && time.Now().Add(delta).After(t.Expiry)
+}
+
+// OAuth2Error represents an error response of the token endpoint.
+type OAuth2Error struct {
+	StatusCode       int    `json:"-"`
+	ErrorCode        string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (e *OAuth2Error) Error() string {
+	if e.ErrorDescription != "" {
+		return fmt.Sprintf("oauth2: %d %s: %s", e.StatusCode, e.ErrorCode, e.ErrorDescription)
+	}
+	return fmt.Sprintf("oauth2: %d %s", e.StatusCode, e.ErrorCode)
+}
+
+// Exchange exchanges the authorization code returned to RedirectURL for the tokens.
+func (c *OAuth2Config) Exchange(ctx context.Context, code string) (*OAuth2Token, error) {
+	return c.token(ctx, map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"code":          code,
+		"redirect_uri":  c.RedirectURL,
+	})
+}
+
+// Refresh returns new tokens for the refresh token, the returned refresh token replaces the old one.
+func (c *OAuth2Config) Refresh(ctx context.Context, refreshToken string) (*OAuth2Token, error) {
+	return c.token(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"refresh_token": refreshToken,
+	})
+}
+
+func (c *OAuth2Config) token(ctx context.Context, body map[string]string) (*OAuth2Token, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, orDefault(c.TokenURL, AtlassianTokenURL), bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		e := &OAuth2Error{StatusCode: resp.StatusCode}
+		if json.Unmarshal(data, e) != nil || e.ErrorCode == "" {
+			e.ErrorCode = http.StatusText(resp.StatusCode)
+		}
+		return nil, e
+	}
+
+	var result struct {
+		OAuth2Token
+		ExpiresIn int64 `json:"expires_in"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	if result.AccessToken == "" {
+		return nil, fmt.Errorf("oauth2: no access_token in the response")
+	}
+	token := result.OAuth2Token
+	if result.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	return &token, nil
+}
+
+// AccessibleResource represents a site the app has been granted access to.
+type AccessibleResource struct {
+	// ID is the cloud id of the site.
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	AvatarURL string   `json:"avatarUrl"`
+}
+
+// AccessibleResources returns the sites the token has been granted access to, the cloud id is the ID of a site.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/#3-1-get-the-cloudid-for-your-site
+func (c *OAuth2Config) AccessibleResources(ctx context.Context, token *OAuth2Token) ([]*AccessibleResource, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, orDefault(c.ResourcesURL, AtlassianResourcesURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("oauth2: accessible resources: %s", resp.Status)
+	}
+	var resources []*AccessibleResource
+	if err := json.NewDecoder(resp.Body).Decode(&resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// TokenStore persists the tokens of the grants, e.g. by customer of a multi-tenant service.
+// SaveToken is called with the new tokens after every refresh, because the refresh token is rotated.
+type TokenStore interface {
+	// Token returns the tokens stored for key, or ErrTokenNotFound.
+	Token(ctx context.Context, key string) (*OAuth2Token, error)
+	SaveToken(ctx context.Context, key string, token *OAuth2Token) error
+}
+
+// MemoryTokenStore is a TokenStore in memory.
+type MemoryTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string]*OAuth2Token
+}
+
+func (m *MemoryTokenStore) Token(_ context.Context, key string) (*OAuth2Token, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	token, ok := m.tokens[key]
+	if !ok {
+		return nil, ErrTokenNotFound
+	}
+	copied := *token
+	return &copied, nil
+}
+
+func (m *MemoryTokenStore) SaveToken(_ context.Context, key string, token *OAuth2Token) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.tokens == nil {
+		m.tokens = make(map[string]*OAuth2Token)
+	}
+	copied := *token
+	m.tokens[key] = &copied
+	return nil
+}
+
+// OAuth2 is the Credential of an OAuth 2.0 (3LO) grant, the requests are sent to the site of CloudID through APIURL.
+// The access token is read from Store and refreshed before its expiry.
+//
+// The refresh is serialized within an OAuth2, share it between the clients of the same grant.
+// Services running several instances must serialize the refresh in Store, since the refresh token is rotated.
+type OAuth2 struct {
+	Config *OAuth2Config
+	// CloudID of the site, see OAuth2Config.AccessibleResources.
+	CloudID string
+	Store   TokenStore
+	// Key of the tokens in Store. Default: CloudID
+	Key string
+	// ExpiryDelta is how long before its expiry the access token is refreshed. Default: DefaultOAuth2ExpiryDelta
+	ExpiryDelta time.Duration
+
+	mu sync.Mutex
+}
+
+func (o *OAuth2) GetEndpoint() string {
+	if o.Config == nil {
+		return ""
+	}
+	return strings.TrimRight(orDefault(o.Config.APIURL, AtlassianAPIURL), "/") + "/" + o.CloudID
+}
+
+func (o *OAuth2) GenerateCallOptions() (*ghttp.CallOptions, error) {
+	return &ghttp.CallOptions{
+		BeforeHook: func(req *http.Request) error {
+			token, err := o.Token(req.Context())
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+			return nil
+		},
+	}, nil
+}
+
+func (o *OAuth2) Valid() error {
+	if o.Config == nil || o.Config.ClientID == "" || o.CloudID == "" || o.Store == nil {
+		return ErrCredential
+	}
+	return nil
+}
+
+func (o *OAuth2) key() string {
+	return orDefault(o.Key, o.CloudID)
+}
+
+// Token returns the tokens of Store, refreshed and saved if the access token is about to expire.
+func (o *OAuth2) Token(ctx context.Context) (*OAuth2Token, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	token, err := o.Store.Token(ctx, o.key())
+	if err != nil {
+		return nil, err
+	}
+	delta := o.ExpiryDelta
+	if delta <= 0 {
+		delta = DefaultOAuth2ExpiryDelta
+	}
+	if !token.expired(delta) {
+		return token, nil
+	}
+	if token.RefreshToken == "" {
+		return nil, fmt.Errorf("oauth2: the access token expires at %s and there is no refresh token, `offline_access` scope is required", token.Expiry)
+	}
+
+	refreshed, err := o.Config.Refresh(ctx, token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = token.RefreshToken
+	}
+	if err := o.Store.SaveToken(ctx, o.key(), refreshed); err != nil {
+		return nil, err
+	}
+	return refreshed, nil
+}
@@ -0,0 +1,121 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestOAuth2Config_AuthCodeURL(t *testing.T) {
+	config := &OAuth2Config{ClientID: "id", RedirectURL: "https://app.example.com/callback", Scopes: []string{"read:jira-work", "offline_access"}}
+	u, err := url.Parse(config.AuthCodeURL("xyz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := u.Query()
+	if u.Host != "auth.atlassian.com" || query.Get("scope") != "read:jira-work offline_access" || query.Get("state") != "xyz" ||
+		query.Get("audience") != "api.atlassian.com" || query.Get("response_type") != "code" || query.Get("redirect_uri") != config.RedirectURL {
+		t.Errorf("got %s", u)
+	}
+}
+
+func TestOAuth2(t *testing.T) {
+	var grants []map[string]string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		grants = append(grants, body)
+		w.Header().Set("Content-Type", "application/json")
+		switch body["grant_type"] {
+		case "authorization_code":
+			_, _ = w.Write([]byte(`{"access_token":"a1","refresh_token":"r1","expires_in":30}`))
+		case "refresh_token":
+			if body["refresh_token"] != "r1" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"Unknown or invalid refresh token."}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token":"a2","refresh_token":"r2","expires_in":3600}`))
+		}
+	})
+	mux.HandleFunc("/oauth/token/accessible-resources", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer a1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":"cloud-1","url":"https://example.atlassian.net","name":"example"}]`))
+	})
+	var authorization string
+	mux.HandleFunc("/ex/jira/cloud-1/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"accountId":"5b10ac8d82e05b22cc7d4ef5"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := &OAuth2Config{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		RedirectURL:  "https://app.example.com/callback",
+		TokenURL:     server.URL + "/oauth/token",
+		ResourcesURL: server.URL + "/oauth/token/accessible-resources",
+		APIURL:       server.URL + "/ex/jira/",
+	}
+	ctx := context.Background()
+	token, err := config.Exchange(ctx, "code")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "a1" || token.Expiry.IsZero() {
+		t.Fatalf("got token %+v", token)
+	}
+	resources, err := config.AccessibleResources(ctx, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resources) != 1 || resources[0].ID != "cloud-1" {
+		t.Fatalf("got resources %+v", resources)
+	}
+
+	store := &MemoryTokenStore{}
+	if err := store.SaveToken(ctx, "cloud-1", token); err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewClient(&OAuth2{Config: config, CloudID: resources[0].ID, Store: store}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the access token expires within DefaultOAuth2ExpiryDelta, it is refreshed
+	if _, err := client.User.GetCurrentUser(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if authorization != "Bearer a2" {
+		t.Errorf("got Authorization %q, want %q", authorization, "Bearer a2")
+	}
+	saved, err := store.Token(ctx, "cloud-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.RefreshToken != "r2" || time.Until(saved.Expiry) < time.Hour-time.Minute {
+		t.Errorf("got saved token %+v", saved)
+	}
+	// the saved token is valid, it is not refreshed again
+	if _, err := client.User.GetCurrentUser(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(grants) != 2 || grants[1]["grant_type"] != "refresh_token" {
+		t.Errorf("got grants %v", grants)
+	}
+
+	if _, err := config.Refresh(ctx, "r1-old"); err == nil {
+		t.Error("got no error refreshing an invalid token")
+	} else if e, ok := err.(*OAuth2Error); !ok || e.ErrorCode != "invalid_grant" || e.StatusCode != http.StatusForbidden {
+		t.Errorf("got error %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package jira
+
+import "context"
+
+// DefaultPageSize is the page size of Pager when PagerOptions.PageSize is not set, the same as the Jira default.
+const DefaultPageSize = 50
+
+// PageFunc fetches the page starting at startAt with at most maxResults values.
+type PageFunc[T any] func(ctx context.Context, startAt, maxResults int) (*Pagination[T], error)
+
+type PagerOptions struct {
+	// StartAt: The index of the first value. Base index: 0.
+	StartAt int
+	// PageSize: The maximum number of values requested per page. Default: DefaultPageSize.
+	PageSize int
+	// Prefetch: The maximum number of pages fetched ahead while the current page is consumed, 0 disables prefetching.
+	Prefetch int
+}
+
+// Pager walks every page of a list endpoint.
+// The end of the list is detected from Pagination.IsLast, then Pagination.Total,
+// otherwise a page shorter than the page size is the last one, which covers the endpoints returning a plain array.
+type Pager[T any] struct {
+	fetch PageFunc[T]
+	opts  PagerOptions
+}
+
+// NewPager returns a Pager calling fetch for each page.
+func NewPager[T any](fetch PageFunc[T], opts *PagerOptions) *Pager[T] {
+	p := &Pager[T]{fetch: fetch}
+	if opts != nil {
+		p.opts = *opts
+	}
+	if p.opts.PageSize <= 0 {
+		p.opts.PageSize = DefaultPageSize
+	}
+	return p
+}
+
+// next returns the startAt of the page after page, or -1 if page is the last one.
+func (p *Pager[T]) next(page *Pagination[T], startAt int) int {
+	n := len(page.Values)
+	if n == 0 || page.IsLast {
+		return -1
+	}
+	next := startAt + n
+	if page.Total > 0 {
+		if next >= page.Total {
+			return -1
+		}
+		return next
+	}
+	// Jira may cap the page size lower than requested
+	size := p.opts.PageSize
+	if page.MaxResults > 0 && page.MaxResults < size {
+		size = page.MaxResults
+	}
+	if n < size {
+		return -1
+	}
+	return next
+}
+
+type pageResult[T any] struct {
+	page *Pagination[T]
+	err  error
+}
+
+// ForEach calls fn for each value of every page.
+// It stops at the first error returned by fn or by fetch, or when ctx is cancelled.
+func (p *Pager[T]) ForEach(ctx context.Context, fn func(value *T) error) error {
+	if p.opts.Prefetch <= 0 {
+		for startAt := p.opts.StartAt; startAt >= 0; {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			page, err := p.fetch(ctx, startAt, p.opts.PageSize)
+			if err != nil {
+				return err
+			}
+			if err := p.each(ctx, page, fn); err != nil {
+				return err
+			}
+			startAt = p.next(page, startAt)
+		}
+		return nil
+	}
+
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// one page is always in flight, the buffer holds the rest of the prefetched pages
+	pages := make(chan pageResult[T], p.opts.Prefetch-1)
+	go func() {
+		defer close(pages)
+		for startAt := p.opts.StartAt; startAt >= 0; {
+			page, err := p.fetch(fetchCtx, startAt, p.opts.PageSize)
+			select {
+			case pages <- pageResult[T]{page: page, err: err}:
+			case <-fetchCtx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+			startAt = p.next(page, startAt)
+		}
+	}()
+
+	for result := range pages {
+		if result.err != nil {
+			return result.err
+		}
+		if err := p.each(ctx, result.page, fn); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+func (p *Pager[T]) each(ctx context.Context, page *Pagination[T], fn func(value *T) error) error {
+	for _, value := range page.Values {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Collect returns the values of every page.
+func (p *Pager[T]) Collect(ctx context.Context) ([]*T, error) {
+	var values []*T
+	err := p.ForEach(ctx, func(value *T) error {
+		values = append(values, value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// arrayPage wraps the values of an endpoint returning a plain array, the page size is used to detect the last page.
+func arrayPage[T any](values []*T, startAt, maxResults int) *Pagination[T] {
+	return &Pagination[T]{
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Values:     values,
+	}
+}
+
+// pageSearchOptions returns the SearchOptions of a page, keeping the expand of opts.
+func pageSearchOptions(opts *SearchOptions, startAt, maxResults int) *SearchOptions {
+	page := &SearchOptions{
+		StartAt:    startAt,
+		MaxResults: maxResults,
+	}
+	if opts != nil {
+		page.Expand = opts.Expand
+	}
+	return page
+}
@@ -0,0 +1,27 @@
+//go:build go1.23
+
+package jira
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+var errStopIteration = errors.New("stop iteration")
+
+// All returns an iterator over the values of every page, for use with range-over-func.
+// A fetch error is yielded once with a nil value and ends the iteration.
+func (p *Pager[T]) All(ctx context.Context) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		err := p.ForEach(ctx, func(value *T) error {
+			if !yield(value, nil) {
+				return errStopIteration
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errStopIteration) {
+			yield(nil, err)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+//go:build go1.23
+
+package jira
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPager_All(t *testing.T) {
+	fetch, _ := testPageFunc(10, func(page *Pagination[int], startAt int) {})
+	var got []int
+	for value, err := range NewPager(fetch, &PagerOptions{PageSize: 4}).All(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, *value)
+		if len(got) == 5 {
+			break
+		}
+	}
+	if len(got) != 5 || got[4] != 4 {
+		t.Fatalf("unexpected values: %v", got)
+	}
+}
@@ -0,0 +1,84 @@
+package jira
+
+import (
+	"context"
+	"testing"
+)
+
+// testPageFunc returns a PageFunc over n values, style builds the paging metadata of a page.
+func testPageFunc(n int, style func(page *Pagination[int], startAt int)) (PageFunc[int], *int) {
+	calls := new(int)
+	return func(ctx context.Context, startAt, maxResults int) (*Pagination[int], error) {
+		*calls++
+		page := &Pagination[int]{StartAt: startAt}
+		for i := startAt; i < n && i < startAt+maxResults; i++ {
+			v := i
+			page.Values = append(page.Values, &v)
+		}
+		style(page, startAt)
+		return page, nil
+	}, calls
+}
+
+func TestPager_ForEach(t *testing.T) {
+	const n = 7
+	tests := []struct {
+		name  string
+		style func(page *Pagination[int], startAt int)
+		calls int
+	}{
+		{
+			name: "isLast",
+			style: func(page *Pagination[int], startAt int) {
+				page.IsLast = startAt+len(page.Values) >= n
+			},
+			calls: 3,
+		},
+		{
+			name: "total",
+			style: func(page *Pagination[int], startAt int) {
+				page.Total = n
+			},
+			calls: 3,
+		},
+		{
+			name:  "short page",
+			style: func(page *Pagination[int], startAt int) {},
+			calls: 3,
+		},
+	}
+	for _, tt := range tests {
+		for _, prefetch := range []int{0, 1, 2} {
+			fetch, calls := testPageFunc(n, tt.style)
+			values, err := NewPager(fetch, &PagerOptions{PageSize: 3, Prefetch: prefetch}).Collect(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(values) != n || *values[n-1] != n-1 {
+				t.Errorf("%s prefetch %d: got %d values", tt.name, prefetch, len(values))
+			}
+			if *calls != tt.calls {
+				t.Errorf("%s prefetch %d: got %d calls, want %d", tt.name, prefetch, *calls, tt.calls)
+			}
+		}
+	}
+}
+
+func TestPager_ForEach_Cancel(t *testing.T) {
+	fetch, _ := testPageFunc(100, func(page *Pagination[int], startAt int) {})
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+	err := NewPager(fetch, &PagerOptions{PageSize: 10, Prefetch: 2}).ForEach(ctx, func(value *int) error {
+		count++
+		if count == 15 {
+			cancel()
+		}
+		return nil
+	})
+	if err != context.Canceled {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+	if count != 15 {
+		t.Fatalf("got %d values after cancel", count)
+	}
+}
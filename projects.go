@@ -52,6 +52,18 @@ func (s *ProjectsService) ListProjects(ctx context.Context, opts *ListProjectOpt
 	return &projects, nil
 }
 
+// ListProjectsPager returns a Pager over every project matching opts, the paging of opts.SearchOptions is replaced by pagerOpts.
+func (s *ProjectsService) ListProjectsPager(opts *ListProjectOptions, pagerOpts *PagerOptions) *Pager[Project] {
+	return NewPager(func(ctx context.Context, startAt, maxResults int) (*Pagination[Project], error) {
+		var page ListProjectOptions
+		if opts != nil {
+			page = *opts
+		}
+		page.SearchOptions = pageSearchOptions(page.SearchOptions, startAt, maxResults)
+		return s.ListProjects(ctx, &page)
+	}, pagerOpts)
+}
+
 type GetProjectOptions struct {
 	Expand     *string  `query:"expand,omitempty"`
 	Properties []string `query:"properties,omitempty"`
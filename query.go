@@ -0,0 +1,103 @@
+package jira
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// encodeQuery encodes the fields of the struct v tagged by `query:"name,omitempty"` to the query parameters.
+// A slice is encoded as a repeated parameter, a field tagged by `query:",inline"` is encoded as part of v.
+// A nil v, or a nil pointer, encodes to no parameters.
+func encodeQuery(v interface{}) (url.Values, error) {
+	query := url.Values{}
+	if v == nil {
+		return query, nil
+	}
+	if err := encodeQueryStruct(query, reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+func encodeQueryStruct(query url.Values, rv reflect.Value) error {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("query: %s is not a struct", rv.Type())
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag, ok := field.Tag.Lookup("query")
+		if !ok || tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		value := rv.Field(i)
+		if options == "inline" {
+			if err := encodeQueryStruct(query, value); err != nil {
+				return err
+			}
+			continue
+		}
+		if options == "omitempty" && value.IsZero() {
+			continue
+		}
+		for value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				break
+			}
+			value = value.Elem()
+		}
+		if value.Kind() == reflect.Pointer {
+			continue
+		}
+
+		if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+			for j := 0; j < value.Len(); j++ {
+				s, err := formatQueryValue(value.Index(j))
+				if err != nil {
+					return fmt.Errorf("query: %s: %w", name, err)
+				}
+				query.Add(name, s)
+			}
+			continue
+		}
+		s, err := formatQueryValue(value)
+		if err != nil {
+			return fmt.Errorf("query: %s: %w", name, err)
+		}
+		query.Add(name, s)
+	}
+	return nil
+}
+
+func formatQueryValue(value reflect.Value) (string, error) {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "", nil
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, value.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("unsupported type %s", value.Type())
+}
@@ -0,0 +1,52 @@
+package jira
+
+import (
+	"testing"
+
+	"github.com/zdz1715/go-utils/goutils"
+)
+
+func TestEncodeQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{name: "nil", v: nil, want: ""},
+		{name: "nil pointer", v: (*GetIssueOptions)(nil), want: ""},
+		{name: "omitempty", v: &GetIssueOptions{}, want: ""},
+		{
+			name: "pointers and slices",
+			v: &GetIssueOptions{
+				Fields:       []string{"summary", "status"},
+				FieldsByKeys: goutils.Ptr(false),
+				Expand:       goutils.Ptr("names,changelog"),
+			},
+			want: "expand=names%2Cchangelog&fields=summary&fields=status&fieldsByKeys=false",
+		},
+		{
+			name: "inline",
+			v: &ListProjectOptions{
+				SearchOptions: &SearchOptions{StartAt: 50, MaxResults: 10},
+				Keys:          []string{"A", "B"},
+			},
+			want: "keys=A&keys=B&maxResults=10&startAt=50",
+		},
+		{name: "nil inline", v: &ListProjectOptions{}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := encodeQuery(tt.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := query.Encode(); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := encodeQuery("jql"); err == nil {
+		t.Error("want an error of a value which is not a struct")
+	}
+}
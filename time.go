@@ -0,0 +1,92 @@
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	// TimeLayout is the layout of the timestamps sent to Jira, e.g. 2006-01-02T15:04:05.000-0700
+	TimeLayout = "2006-01-02T15:04:05.000-0700"
+	// DateLayout is the layout of the dates sent to Jira, e.g. 2006-01-02
+	DateLayout = "2006-01-02"
+)
+
+// timeLayouts are the layouts accepted when decoding.
+// The fractional seconds are optional for every layout with seconds.
+var timeLayouts = []string{
+	"2006-01-02T15:04:05Z0700",  // 2006-01-02T15:04:05.000-0700, 2006-01-02T15:04:05.000Z
+	"2006-01-02T15:04:05Z07:00", // RFC 3339
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z0700",
+	DateLayout,
+}
+
+func parseTime(b []byte) (time.Time, bool, error) {
+	if bytes.Equal(b, []byte("null")) {
+		return time.Time{}, false, nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return time.Time{}, false, err
+	}
+	if s == "" {
+		return time.Time{}, false, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true, nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("cannot parse %q as a Jira time", s)
+}
+
+// Time represents a Jira timestamp.
+// It decodes the timestamps with or without milliseconds, with or without colon in the offset and the dates,
+// and encodes as TimeLayout, a zero Time is encoded as null.
+type Time struct {
+	time.Time
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(TimeLayout))
+}
+
+func (t *Time) UnmarshalJSON(b []byte) error {
+	parsed, _, err := parseTime(b)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
+
+// Date represents a Jira date without time, e.g. due date and release date.
+// It decodes any format accepted by Time, and encodes as DateLayout, a zero Date is encoded as null.
+type Date struct {
+	time.Time
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.Format(DateLayout))
+}
+
+func (d *Date) UnmarshalJSON(b []byte) error {
+	parsed, ok, err := parseTime(b)
+	if err != nil {
+		return err
+	}
+	if ok {
+		parsed = time.Date(parsed.Year(), parsed.Month(), parsed.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	d.Time = parsed
+	return nil
+}
@@ -0,0 +1,90 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	want := time.Date(2024, 3, 5, 14, 30, 15, 0, time.FixedZone("", 8*3600))
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: `"2024-03-05T14:30:15.000+0800"`, want: want},
+		{in: `"2024-03-05T14:30:15+0800"`, want: want},
+		{in: `"2024-03-05T14:30:15+08:00"`, want: want},
+		{in: `"2024-03-05T06:30:15.000Z"`, want: want},
+		{in: `"2024-03-05"`, want: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{in: `null`},
+		{in: `""`},
+	}
+	for _, tt := range tests {
+		var got Time
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Fatalf("%s: %v", tt.in, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	var invalid Time
+	if err := json.Unmarshal([]byte(`"05/Mar/24"`), &invalid); err == nil {
+		t.Error("expected an error for an invalid time")
+	}
+}
+
+func TestIssue_TimeRoundTrip(t *testing.T) {
+	payload := `{
+		"key": "TEST-1",
+		"fields": {
+			"created": "2024-03-05T14:30:15.123+0800",
+			"updated": "2024-03-06T09:00:00.000+0800",
+			"duedate": "2024-03-31",
+			"comment": {"comments": [{"id": "1", "created": "2024-03-05T15:00:00.000+0800"}]},
+			"worklog": {"worklogs": [{"id": "2", "started": "2024-03-05T10:00:00.000+0800"}]},
+			"attachment": [{"id": "3", "created": "2024-03-05T16:00:00.000+0800"}],
+			"fixVersions": [{"id": "4", "releaseDate": "2024-04-01"}]
+		},
+		"changelog": {"histories": [{"id": "5", "created": "2024-03-06T09:00:00.000+0800"}]}
+	}`
+	var issue Issue
+	if err := json.Unmarshal([]byte(payload), &issue); err != nil {
+		t.Fatal(err)
+	}
+	if got := issue.Fields.Created.Format(TimeLayout); got != "2024-03-05T14:30:15.123+0800" {
+		t.Errorf("created: got %s", got)
+	}
+	if got := issue.Fields.Duedate.Format(DateLayout); got != "2024-03-31" {
+		t.Errorf("duedate: got %s", got)
+	}
+
+	b, err := json.Marshal(&issue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again Issue
+	if err := json.Unmarshal(b, &again); err != nil {
+		t.Fatal(err)
+	}
+	checks := []struct {
+		name      string
+		got, want time.Time
+	}{
+		{"created", again.Fields.Created.Time, issue.Fields.Created.Time},
+		{"updated", again.Fields.Updated.Time, issue.Fields.Updated.Time},
+		{"duedate", again.Fields.Duedate.Time, issue.Fields.Duedate.Time},
+		{"comment", again.Fields.Comments.Comments[0].Created.Time, issue.Fields.Comments.Comments[0].Created.Time},
+		{"worklog", again.Fields.Worklog.Worklogs[0].Started.Time, issue.Fields.Worklog.Worklogs[0].Started.Time},
+		{"attachment", again.Fields.Attachments[0].Created.Time, issue.Fields.Attachments[0].Created.Time},
+		{"fixVersion", again.Fields.FixVersions[0].ReleaseDate.Time, issue.Fields.FixVersions[0].ReleaseDate.Time},
+		{"changelog", again.Changelog.Histories[0].Created.Time, issue.Changelog.Histories[0].Created.Time},
+	}
+	for _, c := range checks {
+		if c.got.IsZero() || !c.got.Equal(c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
@@ -17,3 +17,12 @@ const (
 	BaseIssueTypLevel
 	EpicIssueTypLevel
 )
+
+// DeploymentType of the Jira instance, as returned by ServerInfo
+type DeploymentType string
+
+const (
+	CloudDeploymentType      DeploymentType = "Cloud"
+	ServerDeploymentType     DeploymentType = "Server"
+	DataCenterDeploymentType DeploymentType = "DataCenter"
+)
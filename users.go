@@ -2,6 +2,7 @@ package jira
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 )
 
@@ -26,6 +27,28 @@ type User struct {
 	ApplicationRoles ApplicationRoles `json:"applicationRoles,omitempty" structs:"applicationRoles,omitempty"`
 }
 
+// userIdentifier returns the identifier of the user on the Jira deployment and the name of its query parameter,
+// the accountId on Cloud, the username on Server and Data Center.
+func (c *Client) userIdentifier(ctx context.Context, user *User) (param, id string, err error) {
+	if user == nil {
+		return "", "", fmt.Errorf("nil user")
+	}
+	deploymentType, err := c.DeploymentType(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if deploymentType == CloudDeploymentType {
+		if user.AccountID == "" {
+			return "", "", fmt.Errorf("user has no accountId, which is required by Jira Cloud")
+		}
+		return "accountId", user.AccountID, nil
+	}
+	if user.Name == "" {
+		return "", "", fmt.Errorf("user has no name, which is required by Jira Server")
+	}
+	return "username", user.Name, nil
+}
+
 // AvatarUrls represents different dimensions of avatars / images
 type AvatarUrls struct {
 	Four8X48  string `json:"48x48,omitempty" structs:"48x48,omitempty"`
@@ -96,6 +119,17 @@ func (s *UsersService) GetAllUsers(ctx context.Context, search *SearchOptions) (
 	return user, nil
 }
 
+// GetAllUsersPager returns a Pager over all users, the expand of search is kept for every page.
+func (s *UsersService) GetAllUsersPager(search *SearchOptions, pagerOpts *PagerOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt, maxResults int) (*Pagination[User], error) {
+		users, err := s.GetAllUsers(ctx, pageSearchOptions(search, startAt, maxResults))
+		if err != nil {
+			return nil, err
+		}
+		return arrayPage(users, startAt, maxResults), nil
+	}, pagerOpts)
+}
+
 type FindUsersOptions struct {
 	*SearchOptions `query:",inline"`
 
@@ -119,6 +153,22 @@ func (s *UsersService) FindUsers(ctx context.Context, req *FindUsersOptions) ([]
 	return user, nil
 }
 
+// FindUsersPager returns a Pager over every user found by req, the paging of req.SearchOptions is replaced by pagerOpts.
+func (s *UsersService) FindUsersPager(req *FindUsersOptions, pagerOpts *PagerOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt, maxResults int) (*Pagination[User], error) {
+		var page FindUsersOptions
+		if req != nil {
+			page = *req
+		}
+		page.SearchOptions = pageSearchOptions(page.SearchOptions, startAt, maxResults)
+		users, err := s.FindUsers(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+		return arrayPage(users, startAt, maxResults), nil
+	}, pagerOpts)
+}
+
 type FindUsersByQueryOptions struct {
 	*SearchOptions `query:",inline"`
 
@@ -141,6 +191,74 @@ func (s *UsersService) FindUsersByQuery(ctx context.Context, req *FindUsersByQue
 	return user, nil
 }
 
+// FindUsersByQueryPager returns a Pager over every user found by req, the paging of req.SearchOptions is replaced by pagerOpts.
+func (s *UsersService) FindUsersByQueryPager(req *FindUsersByQueryOptions, pagerOpts *PagerOptions) *Pager[User] {
+	return NewPager(func(ctx context.Context, startAt, maxResults int) (*Pagination[User], error) {
+		var page FindUsersByQueryOptions
+		if req != nil {
+			page = *req
+		}
+		page.SearchOptions = pageSearchOptions(page.SearchOptions, startAt, maxResults)
+		users, err := s.FindUsersByQuery(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+		return arrayPage(users, startAt, maxResults), nil
+	}, pagerOpts)
+}
+
+type FindAssignableUsersOptions struct {
+	*SearchOptions `query:",inline"`
+
+	// Query field will search users displayName and emailAddress
+	Query *string `query:"query,omitempty"`
+	// Username is used by Jira Server and Data Center.
+	Username  *string `query:"username,omitempty"`
+	AccountId *string `query:"accountId,omitempty"`
+	// Project or IssueKey is required, Project for the users assignable to a new issue of the project.
+	Project  *string `query:"project,omitempty"`
+	IssueKey *string `query:"issueKey,omitempty"`
+	// ActionDescriptorId is the id of the transition, for the users assignable during the transition.
+	ActionDescriptorId *int  `query:"actionDescriptorId,omitempty"`
+	Recommend          *bool `query:"recommend,omitempty"`
+}
+
+// FindAssignableUsers returns the users who can be assigned to an issue, or to a new issue of a project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-user-search/#api-rest-api-2-user-assignable-search-get
+func (s *UsersService) FindAssignableUsers(ctx context.Context, req *FindAssignableUsersOptions) ([]*User, error) {
+	const apiEndpoint = "/rest/api/2/user/assignable/search"
+	var user []*User
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, req, &user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+type FindMultiProjectAssignableUsersOptions struct {
+	*SearchOptions `query:",inline"`
+
+	// Query field will search users displayName and emailAddress
+	Query *string `query:"query,omitempty"`
+	// Username is used by Jira Server and Data Center.
+	Username  *string `query:"username,omitempty"`
+	AccountId *string `query:"accountId,omitempty"`
+	// ProjectKeys is a comma-separated list of project keys, e.g. `PROJ,TEST`
+	ProjectKeys string `query:"projectKeys"`
+}
+
+// FindMultiProjectAssignableUsers returns the users who can be assigned to issues of all the projects.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-user-search/#api-rest-api-2-user-assignable-multiprojectsearch-get
+func (s *UsersService) FindMultiProjectAssignableUsers(ctx context.Context, req *FindMultiProjectAssignableUsersOptions) ([]*User, error) {
+	const apiEndpoint = "/rest/api/2/user/assignable/multiProjectSearch"
+	var user []*User
+	if err := s.client.Invoke(ctx, http.MethodGet, apiEndpoint, req, &user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
 type CreateUserOptions struct {
 	EmailAddress *string  `json:"emailAddress,omitempty" query:"emailAddress"`
 	Products     []string `json:"products,omitempty" query:"products"`